@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/go-commons/collections"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// AccountIdentity is the account, and the caller within it, that the credentials cloud-nuke runs with belong to
+type AccountIdentity struct {
+	AccountID string
+	// Alias is the account alias, or empty if the account has none or the caller can't list it
+	Alias     string
+	CallerArn string
+}
+
+// GetAccountIdentity looks up the account and caller of the current credentials with STS, and the account alias with
+// IAM. Failing to read the alias is not an error, as many roles aren't allowed to.
+func GetAccountIdentity(ctx context.Context) (*AccountIdentity, error) {
+	output, config, err := getCallerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	identity := &AccountIdentity{
+		AccountID: awsgo.StringValue(output.Account),
+		CallerArn: awsgo.StringValue(output.Arn),
+	}
+
+	services, err := clientsFrom(ctx).Services(config)
+	if err != nil {
+		return nil, err
+	}
+	aliases, err := services.IAM.ListAccountAliasesWithContext(ctx, &iam.ListAccountAliasesInput{})
+	if err != nil {
+		logging.Logger.Debugf("Could not read the alias of account %s: %s", identity.AccountID, err)
+	} else if len(aliases.AccountAliases) > 0 {
+		identity.Alias = awsgo.StringValue(aliases.AccountAliases[0])
+	}
+
+	return identity, nil
+}
+
+// getCallerIdentity calls STS GetCallerIdentity in the default region of each partition that the credentials may
+// belong to, until one accepts them, returning the config of that region. The error of the most likely partition is
+// returned if none does.
+func getCallerIdentity(ctx context.Context) (*sts.GetCallerIdentityOutput, aws.Config, error) {
+	var firstErr error
+	for _, partition := range candidatePartitions(ctx) {
+		config, err := newConfig(ctx, partition.DefaultRegion)
+		if err != nil {
+			return nil, aws.Config{}, errors.WithStackTrace(err)
+		}
+
+		output, err := clientsFrom(ctx).STS(config).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+		if err == nil {
+			return output, config, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, aws.Config{}, errors.WithStackTrace(firstErr)
+}
+
+// IsRoot returns true when the credentials are those of the account's root user
+func (identity *AccountIdentity) IsRoot() bool {
+	return strings.HasSuffix(identity.CallerArn, ":root")
+}
+
+// Partition returns the partition of the account, from the ARN of the caller
+func (identity *AccountIdentity) Partition() (Partition, error) {
+	return PartitionOfArn(identity.CallerArn)
+}
+
+// Matches returns true when input is the account ID or alias, which is what the user types to confirm a nuke
+func (identity *AccountIdentity) Matches(input string) bool {
+	input = strings.TrimSpace(input)
+	return input == identity.AccountID || (identity.Alias != "" && input == identity.Alias)
+}
+
+// ConfirmationValue is what the user is asked to type to confirm a nuke: the alias, or the ID of accounts without one
+func (identity *AccountIdentity) ConfirmationValue() string {
+	if identity.Alias != "" {
+		return identity.Alias
+	}
+	return identity.AccountID
+}
+
+func (identity *AccountIdentity) String() string {
+	if identity.Alias != "" {
+		return fmt.Sprintf("%s (%s) as %s", identity.AccountID, identity.Alias, identity.CallerArn)
+	}
+	return fmt.Sprintf("%s as %s", identity.AccountID, identity.CallerArn)
+}
+
+// CheckAccountAllowed refuses root credentials, accounts in denied, and, when allowed is not empty, any account that
+// is not in it
+func CheckAccountAllowed(identity *AccountIdentity, allowed, denied []string) error {
+	if identity.IsRoot() {
+		return errors.WithStackTrace(RootCredentialsError{CallerArn: identity.CallerArn})
+	}
+	if collections.ListContainsElement(denied, identity.AccountID) {
+		return errors.WithStackTrace(AccountNotAllowedError{AccountID: identity.AccountID, Reason: "it is in the list of denied accounts"})
+	}
+	if len(allowed) > 0 && !collections.ListContainsElement(allowed, identity.AccountID) {
+		return errors.WithStackTrace(AccountNotAllowedError{AccountID: identity.AccountID, Reason: "it is not in the list of allowed accounts"})
+	}
+	return nil
+}
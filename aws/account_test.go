@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountIdentityConfirmation(t *testing.T) {
+	t.Parallel()
+
+	identity := &AccountIdentity{AccountID: "123456789012", Alias: "sandbox", CallerArn: "arn:aws:sts::123456789012:assumed-role/admin/me"}
+	assert.Equal(t, "sandbox", identity.ConfirmationValue())
+	assert.True(t, identity.Matches("sandbox"))
+	assert.True(t, identity.Matches(" 123456789012\n"))
+	assert.False(t, identity.Matches("nuke"))
+	assert.False(t, identity.IsRoot())
+
+	withoutAlias := &AccountIdentity{AccountID: "123456789012", CallerArn: "arn:aws:iam::123456789012:root"}
+	assert.Equal(t, "123456789012", withoutAlias.ConfirmationValue())
+	assert.False(t, withoutAlias.Matches(""))
+	assert.True(t, withoutAlias.IsRoot())
+}
+
+func TestCheckAccountAllowed(t *testing.T) {
+	t.Parallel()
+
+	user := &AccountIdentity{AccountID: "111111111111", CallerArn: "arn:aws:iam::111111111111:user/me"}
+	root := &AccountIdentity{AccountID: "111111111111", CallerArn: "arn:aws:iam::111111111111:root"}
+
+	tests := []struct {
+		name     string
+		identity *AccountIdentity
+		allowed  []string
+		denied   []string
+		expected error
+	}{
+		{"NoRules", user, nil, nil, nil},
+		{"Allowed", user, []string{"111111111111"}, nil, nil},
+		{"NotAllowed", user, []string{"222222222222"}, nil, AccountNotAllowedError{AccountID: "111111111111", Reason: "it is not in the list of allowed accounts"}},
+		{"Denied", user, []string{"111111111111"}, []string{"111111111111"}, AccountNotAllowedError{AccountID: "111111111111", Reason: "it is in the list of denied accounts"}},
+		{"Root", root, []string{"111111111111"}, nil, RootCredentialsError{CallerArn: "arn:aws:iam::111111111111:root"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := CheckAccountAllowed(tt.identity, tt.allowed, tt.denied)
+			if tt.expected == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.expected, errors.Unwrap(err))
+		})
+	}
+}
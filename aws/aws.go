@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	cloudformation_types "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -20,89 +19,111 @@ import (
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/go-commons/collections"
 	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
 	"github.com/pterm/pterm"
 )
 
-// OptInNotRequiredRegions contains all regions that are enabled by default on new AWS accounts
-// Beginning in Spring 2019, AWS requires new regions to be explicitly enabled
+// OptInNotRequiredRegions contains all regions that are enabled by default on new AWS accounts, from the partition
+// catalog. Beginning in Spring 2019, AWS requires new regions to be explicitly enabled
 // See https://aws.amazon.com/blogs/security/setting-permissions-to-enable-accounts-for-upcoming-aws-regions/
-var OptInNotRequiredRegions = []string{
-	"eu-north-1",
-	"ap-south-1",
-	"eu-west-3",
-	"eu-west-2",
-	"eu-west-1",
-	"ap-northeast-3",
-	"ap-northeast-2",
-	"ap-northeast-1",
-	"sa-east-1",
-	"ca-central-1",
-	"ap-southeast-1",
-	"ap-southeast-2",
-	"eu-central-1",
-	"us-east-1",
-	"us-east-2",
-	"us-west-1",
-	"us-west-2",
-}
+var OptInNotRequiredRegions = mustPartition("aws").EnabledRegionNames()
 
-// GovCloudRegions contains all of the U.S. GovCloud regions. In accounts with GovCloud enabled, these are the
-// only available regions.
-var GovCloudRegions = []string{
-	"us-gov-east-1",
-	"us-gov-west-1",
-}
+// GovCloudRegions contains all of the U.S. GovCloud regions, from the partition catalog. In accounts with GovCloud
+// enabled, these are the only available regions.
+var GovCloudRegions = mustPartition("aws-us-gov").RegionNames()
 
-const (
-	GlobalRegion string = "global"
-	// us-east-1 is the region that is available in every account
-	defaultRegion string = "us-east-1"
-)
+// GlobalRegion is a fake region, used to represent global resources. Their clients are created in the default region
+// of the partition.
+const GlobalRegion string = "global"
 
-func newConfig(region string) (aws.Config, error) {
-	return externalcreds.Get(region)
+// mustPartition returns a partition of the embedded catalog
+func mustPartition(id string) Partition {
+	partition, err := PartitionByID(id)
+	if err != nil {
+		panic(err)
+	}
+	return partition
 }
 
-// Try a describe regions command with the most likely enabled regions
-func retryDescribeRegions() (*ec2.DescribeRegionsOutput, error) {
-	regionsToTry := append(OptInNotRequiredRegions, GovCloudRegions...)
-	for _, region := range regionsToTry {
-		config, loadConfigErr := newConfig(region)
+// newConfig loads the config of the clients for region, with the credentials that ctx carries, if any
+func newConfig(ctx context.Context, region string) (aws.Config, error) {
+	return externalcreds.Get(ctx, region)
+}
+
+// describeAllRegions describes every region of the account, including those that aren't enabled, with EC2
+// DescribeRegions. It is called in the default region of the partition of ctx, or of each partition that the
+// credentials may belong to until one accepts them.
+func describeAllRegions(ctx context.Context) (*ec2.DescribeRegionsOutput, error) {
+	var firstErr error
+	for _, partition := range candidatePartitions(ctx) {
+		config, loadConfigErr := newConfig(ctx, partition.DefaultRegion)
 		if loadConfigErr != nil {
 			return nil, loadConfigErr
 		}
-		svc := ec2.NewFromConfig(config)
-		regions, err := svc.DescribeRegions(context.Background(), &ec2.DescribeRegionsInput{})
-		if err != nil {
-			continue
+		svc := clientsFrom(ctx).EC2(config)
+		regions, err := svc.DescribeRegions(ctx, &ec2.DescribeRegionsInput{AllRegions: aws.Bool(true)})
+		if err == nil {
+			return regions, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+		if ctx.Err() != nil {
+			break
 		}
-		return regions, nil
 	}
-	return nil, errors.WithStackTrace(fmt.Errorf("could not find any enabled regions"))
+	return nil, errors.WithStackTrace(CouldNotDetermineEnabledRegionsError{Underlying: firstErr})
 }
 
 // GetEnabledRegions - Get all regions that are enabled (DescribeRegions excludes those not enabled by default)
 func GetEnabledRegions() ([]string, error) {
-	var regionNames []string
-
-	// We don't want to depend on a default region being set, so instead we
-	// will choose a region from the list of regions that are enabled by default
-	// and use that to enumerate all enabled regions.
-	// Corner case: user has intentionally disabled one or more regions that are
-	// enabled by default. If that region is chosen, API calls will fail.
-	// Therefore we retry until one of the regions works.
-	regions, err := retryDescribeRegions()
+	return GetEnabledRegionsWithContext(context.Background())
+}
+
+// GetEnabledRegionsWithContext - Get all regions that are enabled in the account of the credentials that ctx carries
+func GetEnabledRegionsWithContext(ctx context.Context) ([]string, error) {
+	regions, err := GetAccountRegions(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, region := range regions.Regions {
-		regionNames = append(regionNames, awsgo.StringValue(region.RegionName))
+	regionNames := []string{}
+	for _, region := range regions {
+		if region.Enabled() {
+			regionNames = append(regionNames, region.Name)
+		}
 	}
-
 	return regionNames, nil
 }
 
+// GetAccountRegions returns every region of the account of the credentials that ctx carries, with its opt-in status,
+// described with the partition catalog. With WithOfflineRegions, they are the regions of the partition in the catalog.
+func GetAccountRegions(ctx context.Context) ([]PartitionRegion, error) {
+	if offlineRegions(ctx) {
+		return partitionOf(ctx).Regions, nil
+	}
+
+	output, err := describeAllRegions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	regions := []PartitionRegion{}
+	for _, described := range output.Regions {
+		region := PartitionRegion{
+			Name:        awsgo.StringValue(described.RegionName),
+			OptInStatus: awsgo.StringValue(described.OptInStatus),
+		}
+		if partition, err := PartitionOfRegion(region.Name); err == nil {
+			if catalogRegion, ok := partition.region(region.Name); ok {
+				region.Description = catalogRegion.Description
+			}
+		}
+		regions = append(regions, region)
+	}
+	return regions, nil
+}
+
 func getRandomRegion() (string, error) {
 	return getRandomRegionWithExclusions([]string{})
 }
@@ -210,8 +231,8 @@ func GetTargetRegions(enabledRegions []string, selectedRegions []string, exclude
 	return targetRegions, nil
 }
 
-// GetAllResources - Lists all aws resources
-func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTypes []string, configObj config.Config) (*AwsAccountResources, error) {
+// GetAllResources - Lists all aws resources. Scanning stops as soon as ctx is cancelled.
+func GetAllResources(ctx context.Context, targetRegions []string, excludeAfter time.Time, resourceTypes []string, configObj config.Config) (*AwsAccountResources, error) {
 	account := AwsAccountResources{
 		Resources: make(map[string]AwsRegionResource),
 	}
@@ -227,36 +248,24 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 
 		logging.Logger.Infof("Checking region [%d/%d]: %s", count, totalRegions, region)
 
-		awsConfig, configLoadErr := newConfig(region)
+		awsConfig, configLoadErr := newConfig(ctx, region)
 		if configLoadErr != nil {
 			return nil, configLoadErr
 		}
 
 		resourcesInRegion := AwsRegionResource{}
 
-		svc := cloudcontrol.NewFromConfig(awsConfig)
-
-		// TODO - move me to the right place
-		/*resourcesToNuke, loadErr := LoadNukePlan()
-		if loadErr != nil {
-			return nil, loadErr
-		}*/
+		svc := clientsFrom(ctx).CloudControl(awsConfig)
 
 		for _, resourceType := range resourceTypes {
-			listInput := &cloudcontrol.ListResourcesInput{
-				TypeName: aws.String(resourceType),
+			if ctx.Err() != nil {
+				return nil, errors.WithStackTrace(ctx.Err())
 			}
 
-			output, err := svc.ListResources(context.TODO(), listInput)
+			resourceIdentifiers, err := listResources(ctx, awsConfig, svc, resourceType)
 			if err != nil {
 				fmt.Printf("Error listing resources: %+v\n", err)
-			}
-
-			resourceIdentifiers := []string{}
-
-			for _, resourceDescription := range output.ResourceDescriptions {
-				logging.Logger.Debugf("Found resource (%s) with properties: %+v\n", aws.ToString(resourceDescription.Identifier), aws.ToString(resourceDescription.Properties))
-				resourceIdentifiers = append(resourceIdentifiers, aws.ToString(resourceDescription.Identifier))
+				continue
 			}
 
 			awsResource := &AwsResource{
@@ -277,16 +286,23 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 	return &account, nil
 }
 
-// ListResourceTypes - Returns list of resources which can be passed to --resource-type
+// ListResourceTypes - Returns list of resources which can be passed to --resource-type: the fully mutable types
+// that Cloud Control supports, plus the types that have a registered ResourceHandler. It includes the opt-in types,
+// which are only nuked when they are passed by name.
 func ListResourceTypes() []string {
-	config, loadConfigErr := newConfig("us-east-1")
+	return ListResourceTypesWithContext(context.Background())
+}
+
+// ListResourceTypesWithContext - Returns the resource types of ListResourceTypes, listed with the clients of ctx
+func ListResourceTypesWithContext(ctx context.Context) []string {
+	config, loadConfigErr := newConfig(ctx, defaultRegion(ctx))
 	if loadConfigErr != nil {
 		logging.Logger.Errorf("Error loading aws config: %+v\n", loadConfigErr)
 	}
 
 	typeNameStrings := []string{}
 
-	svc := cloudformation.NewFromConfig(config)
+	svc := clientsFrom(ctx).CloudFormation(config)
 	listTypesInput := &cloudformation.ListTypesInput{
 		DeprecatedStatus: cloudformation_types.DeprecatedStatusLive,
 		Filters: &cloudformation_types.TypeFilters{
@@ -300,7 +316,7 @@ func ListResourceTypes() []string {
 
 	pageNum := 0
 	for paginator.HasMorePages() {
-		output, err := paginator.NextPage(context.TODO())
+		output, err := paginator.NextPage(ctx)
 		if err != nil {
 			log.Printf("error: %v", err)
 			return typeNameStrings
@@ -311,6 +327,12 @@ func ListResourceTypes() []string {
 		pageNum++
 	}
 
+	for _, typeName := range registeredResourceTypes() {
+		if !collections.ListContainsElement(typeNameStrings, typeName) {
+			typeNameStrings = append(typeNameStrings, typeName)
+		}
+	}
+
 	sort.Strings(typeNameStrings)
 	return typeNameStrings
 }
@@ -330,12 +352,26 @@ func IsNukeable(resourceType string, resourceTypes []string) bool {
 	return false
 }
 
-func nukeAllResourcesInRegion(account *AwsAccountResources, region string, config aws.Config) error {
+// sleepWithContext pauses for the given duration, returning early if ctx is cancelled
+func sleepWithContext(ctx context.Context, duration time.Duration) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(duration):
+	}
+}
+
+// nukeAllResourcesInRegion deletes the resources of a region, batch by batch. A resource that couldn't be deleted, for
+// instance because its preparation failed, is reported as a failed row of the results table and in the journal, and
+// doesn't stop the other resources from being deleted. The returned error combines the errors of every batch.
+func nukeAllResourcesInRegion(ctx context.Context, account *AwsAccountResources, region string, config aws.Config, opts NukeOptions) error {
 	resourcesInRegion := account.Resources[region]
 
 	tableData := make([][]string, 1)
 	tableData = append(tableData, []string{"Resource", "Operation", "Status", "StatusMessage", "Error"})
 
+	var allErrs *multierror.Error
+
+resourceLoop:
 	for _, resources := range resourcesInRegion.Resources {
 		length := len(resources.ResourceIdentifiers())
 
@@ -344,26 +380,32 @@ func nukeAllResourcesInRegion(account *AwsAccountResources, region string, confi
 		batches := split(resources.ResourceIdentifiers(), resources.MaxBatchSize())
 
 		for i := 0; i < len(batches); i++ {
+			// Once interrupted, stop scheduling new deletions but still report on the ones that were submitted
+			if ctx.Err() != nil {
+				logging.Logger.Warnf("Interrupted: no more deletions will be submitted in region %s", region)
+				break resourceLoop
+			}
+
 			batch := batches[i]
-			returnedTableData, err := resources.Nuke(config, batch)
+			returnedTableData, err := resources.Nuke(ctx, config, batch, region, opts)
+			for _, row := range returnedTableData {
+				tableData = append(tableData, row)
+			}
+
 			if err != nil {
 				// TODO: Figure out actual error type
 				if strings.Contains(err.Error(), "RequestLimitExceeded") {
 					logging.Logger.Info("Request limit reached. Waiting 1 minute before making new requests")
-					time.Sleep(1 * time.Minute)
+					sleepWithContext(ctx, 1*time.Minute)
 					continue
 				}
 
-				return errors.WithStackTrace(err)
-			}
-
-			for _, row := range returnedTableData {
-				tableData = append(tableData, row)
+				allErrs = multierror.Append(allErrs, err)
 			}
 
 			if i != len(batches)-1 {
 				logging.Logger.Info("Sleeping for 10 seconds before processing next batch...")
-				time.Sleep(10 * time.Second)
+				sleepWithContext(ctx, 10*time.Second)
 			}
 		}
 	}
@@ -383,7 +425,11 @@ func nukeAllResourcesInRegion(account *AwsAccountResources, region string, confi
 
 	}
 
-	return nil
+	if ctx.Err() != nil {
+		return NukeInterruptedError{}
+	}
+
+	return allErrs.ErrorOrNil()
 }
 
 func renderSection(sectionTitle string) {
@@ -391,29 +437,61 @@ func renderSection(sectionTitle string) {
 	pterm.DefaultSection.WithLevel(0).Println(sectionTitle)
 }
 
-// NukeAllResources - Nukes all aws resources
-func NukeAllResources(account *AwsAccountResources, regions []string) error {
-	for _, region := range regions {
-		// region that will be used to create a session
-		targetRegion := region
+// sessionRegion - Returns the region that will be used to create a session for the given region
+func sessionRegion(ctx context.Context, region string) string {
+	// As there is no actual region named global we have to pick a valid one just to create the session
+	if region == GlobalRegion {
+		return defaultRegion(ctx)
+	}
+	return region
+}
 
-		// As there is no actual region named global we have to pick a valid one just to create the session
-		if region == GlobalRegion {
-			targetRegion = defaultRegion
+// NukeAllResources - Nukes all aws resources, after backing them up if opts.BackupPath is set. A region whose resources
+// couldn't all be deleted doesn't stop the other regions: the errors of every region are combined into the returned
+// error. When ctx is cancelled, no new deletions are submitted, but the results of those already submitted are still
+// reported and a NukeInterruptedError is returned.
+func NukeAllResources(ctx context.Context, account *AwsAccountResources, regions []string, opts NukeOptions) error {
+	// Write the changes to the journal that are still batched, however the nuke ends
+	defer opts.Run.flush()
+
+	if opts.BackupPath != "" {
+		runID := ""
+		if opts.Run != nil {
+			runID = opts.Run.ID
+		}
+		// Nothing is deleted unless the backup was written
+		if err := BackupResources(ctx, account, runID, opts.BackupPath, opts.BackupKey); err != nil {
+			return err
+		}
+	}
+
+	var allErrs *multierror.Error
+	for _, region := range regions {
+		if ctx.Err() != nil {
+			return NukeInterruptedError{}
 		}
 
-		config, err := newConfig(targetRegion)
+		config, err := newConfig(ctx, sessionRegion(ctx, region))
 		if err != nil {
-			return errors.WithStackTrace(err)
+			allErrs = multierror.Append(allErrs, errors.WithStackTrace(err))
+			continue
 		}
 
-		err = nukeAllResourcesInRegion(account, region, config)
-
+		err = nukeAllResourcesInRegion(ctx, account, region, config, opts)
+		if _, interrupted := err.(NukeInterruptedError); interrupted {
+			return err
+		}
 		if err != nil {
-			return errors.WithStackTrace(err)
+			allErrs = multierror.Append(allErrs, errors.WithStackTrace(err))
 		}
+	}
 
+	// The deletions that did succeed are verified even if others failed
+	if opts.Verify {
+		if err := VerifyRun(ctx, opts.Run); err != nil {
+			allErrs = multierror.Append(allErrs, err)
+		}
 	}
 
-	return nil
+	return allErrs.ErrorOrNil()
 }
@@ -1,9 +1,19 @@
 package aws
 
 import (
+	"context"
+	"errors"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/golang/mock/gomock"
+	mock_aws "github.com/gruntwork-io/cloud-nuke/aws/mocks/clients"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSplit(t *testing.T) {
@@ -30,9 +40,6 @@ func TestSplit(t *testing.T) {
 func TestGetTargetRegions(t *testing.T) {
 	t.Parallel()
 
-	actualEnabledRegions, _ := GetEnabledRegions()
-	assert.Greater(t, len(actualEnabledRegions), 0)
-
 	type test struct {
 		enabledRegions  []string
 		selectedRegions []string
@@ -125,3 +132,127 @@ func TestGetTargetRegions(t *testing.T) {
 		assert.NotEqual(t, err, nil)
 	}
 }
+
+func TestNukeAllResourcesStopsWhenInterrupted(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	account := &AwsAccountResources{
+		Resources: map[string]AwsRegionResource{
+			"us-east-1": {
+				Resources: []*AwsResource{
+					{TypeName: "AWS::Logs::LogGroup", Identifiers: []string{"group-a"}},
+				},
+			},
+		},
+	}
+
+	err := NukeAllResources(ctx, account, []string{"us-east-1"}, NukeOptions{})
+	assert.Equal(t, NukeInterruptedError{}, err)
+}
+
+// countingHandler is a ResourceHandler that counts its deletions
+type countingHandler struct {
+	deletions *int
+}
+
+func (handler countingHandler) TypeName() string {
+	return "Test::Handler::Counted"
+}
+
+func (handler countingHandler) List(ctx context.Context, config aws.Config) ([]string, error) {
+	return nil, nil
+}
+
+func (handler countingHandler) Delete(ctx context.Context, config aws.Config, identifier string) error {
+	*handler.deletions++
+	return ctx.Err()
+}
+
+func TestNukeWithHandlerSkipsWhenInterrupted(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	handler := countingHandler{deletions: new(int)}
+	account := &AwsAccountResources{
+		Resources: map[string]AwsRegionResource{
+			"us-east-1": {Resources: []*AwsResource{{TypeName: handler.TypeName(), Identifiers: []string{"counted-a"}}}},
+		},
+	}
+	run := NewRun(filepath.Join(t.TempDir(), "run.json"))
+	require.NoError(t, run.Plan(account))
+
+	result := nukeWithHandler(ctx, handler, aws.Config{}, "counted-a", "us-east-1", NukeOptions{Run: run})
+	assert.Equal(t, "Skipped", result.OperationStatus)
+	assert.NoError(t, result.Error)
+	assert.Equal(t, 0, *handler.deletions)
+
+	require.Len(t, run.Entries, 1)
+	assert.Equal(t, RunEntryPlanned, run.Entries[0].State)
+	assert.Equal(t, 0, run.Entries[0].Attempt)
+}
+
+func TestNukeAllResourcesContinuesAfterFailedPreparation(t *testing.T) {
+	t.Parallel()
+
+	lockedType := "Test::Prepare::Locked"
+	RegisterPreparer(lockedType, func(ctx context.Context, config aws.Config, svc CloudControlAPI, typeName, identifier string) []PrepareStep {
+		return []PrepareStep{{Description: "Unlock " + identifier, Error: errors.New("AccessDenied")}}
+	})
+
+	ctrl := gomock.NewController(t)
+	mockCloudControl := mock_aws.NewMockCloudControlAPI(ctrl)
+	mockCloudControl.EXPECT().
+		DeleteResource(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, input *cloudcontrol.DeleteResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.DeleteResourceOutput, error) {
+			assert.Equal(t, testResourceType, aws.ToString(input.TypeName))
+			return &cloudcontrol.DeleteResourceOutput{ProgressEvent: &types.ProgressEvent{
+				RequestToken:    aws.String("request-" + aws.ToString(input.Identifier)),
+				Operation:       types.OperationDelete,
+				OperationStatus: types.OperationStatusInProgress,
+			}}, nil
+		}).
+		Times(2)
+
+	account := &AwsAccountResources{
+		Resources: map[string]AwsRegionResource{
+			"us-east-1": {
+				Resources: []*AwsResource{
+					{TypeName: lockedType, Identifiers: []string{"locked-a"}},
+					{TypeName: testResourceType, Identifiers: []string{"widget-a"}},
+				},
+			},
+			"eu-west-1": {
+				Resources: []*AwsResource{{TypeName: testResourceType, Identifiers: []string{"widget-b"}}},
+			},
+		},
+	}
+
+	run := NewRun(filepath.Join(t.TempDir(), "run.json"))
+	require.NoError(t, run.Plan(account))
+
+	ctx := WithClientFactory(context.Background(), StaticClientFactory{CloudControlClient: mockCloudControl})
+	err := NukeAllResources(ctx, account, []string{"us-east-1", "eu-west-1"}, NukeOptions{Run: run, Async: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Unlock locked-a")
+
+	failed := run.FailedEntries()
+	require.Len(t, failed, 1)
+	assert.Equal(t, "locked-a", failed[0].Identifier)
+	assert.Len(t, run.PendingEntries(), 2)
+}
+
+func TestSleepWithContextReturnsWhenCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	sleepWithContext(ctx, time.Minute)
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+}
@@ -0,0 +1,289 @@
+package aws
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cloudformation_types "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// encryptedBackupMagic starts every encrypted backup archive. Unencrypted archives are plain gzip files.
+const encryptedBackupMagic = "CLOUDNUKE-BACKUP-AES256GCM-V1\n"
+
+// backupKeySize is the size, in bytes, of the AES-256 keys that encrypt backup archives
+const backupKeySize = 32
+
+// Backup is the content of a backup archive: the full model of every resource that was planned for deletion, written
+// before any of them is deleted
+type Backup struct {
+	RunID     string                  `json:"run_id,omitempty"`
+	CreatedAt time.Time               `json:"created_at"`
+	AccountID string                  `json:"account_id,omitempty"`
+	Schemas   map[string]BackupSchema `json:"schemas,omitempty"`
+	Resources []BackupResource        `json:"resources"`
+}
+
+// BackupSchema is the CloudFormation schema of a resource type, at the version the resources were read with
+type BackupSchema struct {
+	Version string          `json:"version,omitempty"`
+	Schema  json.RawMessage `json:"schema,omitempty"`
+	// Plugin is the path of the plugin that handled the type, for types declared by plugins. Cloud Control can't
+	// create them, so they can't be restored.
+	Plugin string `json:"plugin,omitempty"`
+}
+
+// BackupResource is the model of a single resource. Error is set instead of Properties when the resource could not
+// be read.
+type BackupResource struct {
+	Region        string            `json:"region"`
+	TypeName      string            `json:"type_name"`
+	Identifier    string            `json:"identifier"`
+	SchemaVersion string            `json:"schema_version,omitempty"`
+	Properties    json.RawMessage   `json:"properties,omitempty"`
+	Tags          map[string]string `json:"tags,omitempty"`
+	Error         string            `json:"error,omitempty"`
+}
+
+// BackupResources reads the model of every resource in the account and writes them to a backup archive at path. The
+// archive is encrypted with AES-256-GCM when key is set. Resources that can't be read are recorded with their error,
+// so that the archive lists everything that was nuked; only failing to write the archive is an error.
+func BackupResources(ctx context.Context, account *AwsAccountResources, runID, path string, key []byte) error {
+	backup := &Backup{
+		RunID:     runID,
+		CreatedAt: time.Now().UTC(),
+		Schemas:   map[string]BackupSchema{},
+	}
+
+	for region, resourcesInRegion := range account.Resources {
+		config, err := newConfig(ctx, sessionRegion(ctx, region))
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		if backup.AccountID == "" {
+			backup.AccountID = currentAccountID(ctx, config)
+		}
+
+		svc := clientsFrom(ctx).CloudControl(config)
+		cfnSvc := clientsFrom(ctx).CloudFormation(config)
+
+		for _, resources := range resourcesInRegion.Resources {
+			typeName := resources.ResourceName()
+			if _, ok := backup.Schemas[typeName]; !ok {
+				backup.Schemas[typeName] = describeTypeSchema(ctx, cfnSvc, typeName)
+			}
+
+			for _, identifier := range resources.ResourceIdentifiers() {
+				if ctx.Err() != nil {
+					return errors.WithStackTrace(ctx.Err())
+				}
+
+				resource := BackupResource{
+					Region:        region,
+					TypeName:      typeName,
+					Identifier:    identifier,
+					SchemaVersion: backup.Schemas[typeName].Version,
+				}
+
+				properties, err := describeResource(ctx, config, svc, typeName, identifier)
+				if err != nil {
+					logging.Logger.Warnf("Could not back up %s %s: %s", typeName, identifier, err)
+					resource.Error = err.Error()
+				} else {
+					resource.Properties = json.RawMessage(properties)
+					resource.Tags = extractTags(resource.Properties)
+				}
+				backup.Resources = append(backup.Resources, resource)
+			}
+		}
+	}
+
+	if err := WriteBackup(path, backup, key); err != nil {
+		return err
+	}
+	logging.Logger.Infof("Backed up %d resources to %s", len(backup.Resources), path)
+	return nil
+}
+
+// currentAccountID returns the ID of the account the config's credentials belong to, or an empty string if it can't
+// be determined
+func currentAccountID(ctx context.Context, config aws.Config) string {
+	output, err := clientsFrom(ctx).STS(config).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		logging.Logger.Warnf("Could not determine the account ID: %s", err)
+		return ""
+	}
+	return aws.ToString(output.Account)
+}
+
+// describeTypeSchema returns the default version of the type's schema. Types that are not registered with
+// CloudFormation, such as the CloudNuke:: types and the types of plugins, have no schema.
+func describeTypeSchema(ctx context.Context, svc CloudFormationAPI, typeName string) BackupSchema {
+	if handler, ok := resourceHandlerFor(typeName); ok {
+		if plugin, ok := handler.(pluginHandler); ok {
+			return BackupSchema{Plugin: plugin.plugin.Path}
+		}
+		return BackupSchema{}
+	}
+
+	output, err := svc.DescribeType(ctx, &cloudformation.DescribeTypeInput{
+		Type:     cloudformation_types.RegistryTypeResource,
+		TypeName: aws.String(typeName),
+	})
+	if err != nil {
+		logging.Logger.Warnf("Could not read the schema of %s: %s", typeName, err)
+		return BackupSchema{}
+	}
+
+	schema := BackupSchema{Version: aws.ToString(output.DefaultVersionId)}
+	if output.Schema != nil {
+		schema.Schema = json.RawMessage(aws.ToString(output.Schema))
+	}
+	return schema
+}
+
+// extractTags reads the Tags property of a resource model, which is either a list of Key/Value pairs or a map
+func extractTags(properties json.RawMessage) map[string]string {
+	model := struct {
+		Tags json.RawMessage
+	}{}
+	if err := json.Unmarshal(properties, &model); err != nil || len(model.Tags) == 0 {
+		return nil
+	}
+
+	tagList := []struct {
+		Key   string
+		Value string
+	}{}
+	if err := json.Unmarshal(model.Tags, &tagList); err == nil {
+		tags := map[string]string{}
+		for _, tag := range tagList {
+			tags[tag.Key] = tag.Value
+		}
+		return tags
+	}
+
+	tagMap := map[string]string{}
+	if err := json.Unmarshal(model.Tags, &tagMap); err == nil {
+		return tagMap
+	}
+	return nil
+}
+
+// WriteBackup writes a gzip compressed backup archive, encrypted with AES-256-GCM when key is set
+func WriteBackup(path string, backup *Backup, key []byte) error {
+	var compressed bytes.Buffer
+	gzipWriter := gzip.NewWriter(&compressed)
+	if err := json.NewEncoder(gzipWriter).Encode(backup); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	contents := compressed.Bytes()
+	if key != nil {
+		gcm, err := newBackupCipher(key)
+		if err != nil {
+			return err
+		}
+
+		nonce := make([]byte, gcm.NonceSize())
+		if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		encrypted := append([]byte(encryptedBackupMagic), nonce...)
+		contents = gcm.Seal(encrypted, nonce, contents, []byte(encryptedBackupMagic))
+	}
+
+	// Backups contain the full configuration of resources, which may include secrets
+	return errors.WithStackTrace(ioutil.WriteFile(path, contents, 0600))
+}
+
+// ReadBackup reads a backup archive written by WriteBackup. The key is only needed for encrypted archives.
+func ReadBackup(path string, key []byte) (*Backup, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	if bytes.HasPrefix(contents, []byte(encryptedBackupMagic)) {
+		if key == nil {
+			return nil, errors.WithStackTrace(InvalidBackupError{Path: path, Reason: "the archive is encrypted, but no key was given"})
+		}
+
+		gcm, err := newBackupCipher(key)
+		if err != nil {
+			return nil, err
+		}
+
+		encrypted := contents[len(encryptedBackupMagic):]
+		if len(encrypted) < gcm.NonceSize() {
+			return nil, errors.WithStackTrace(InvalidBackupError{Path: path, Reason: "the archive is truncated"})
+		}
+		nonce, ciphertext := encrypted[:gcm.NonceSize()], encrypted[gcm.NonceSize():]
+		contents, err = gcm.Open(nil, nonce, ciphertext, []byte(encryptedBackupMagic))
+		if err != nil {
+			return nil, errors.WithStackTrace(InvalidBackupError{Path: path, Reason: "the archive could not be decrypted with the given key"})
+		}
+	}
+
+	gzipReader, err := gzip.NewReader(bytes.NewReader(contents))
+	if err != nil {
+		return nil, errors.WithStackTrace(InvalidBackupError{Path: path, Reason: err.Error()})
+	}
+	defer gzipReader.Close()
+
+	backup := &Backup{}
+	if err := json.NewDecoder(gzipReader).Decode(backup); err != nil {
+		return nil, errors.WithStackTrace(InvalidBackupError{Path: path, Reason: err.Error()})
+	}
+	return backup, nil
+}
+
+// LoadBackupKey reads an AES-256 key from a file holding either the hex encoding of the key, such as the output of
+// `openssl rand -hex 32`, or its 32 raw bytes. Contents that are valid hex are always decoded, so that a hex encoded
+// key of the wrong size, such as a 32 character one, is rejected rather than used as raw bytes.
+func LoadBackupKey(path string) ([]byte, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	key, err := hex.DecodeString(strings.TrimSpace(string(contents)))
+	if err != nil {
+		key = contents
+	}
+	if len(key) != backupKeySize {
+		return nil, errors.WithStackTrace(InvalidBackupKeyError{Path: path})
+	}
+	return key, nil
+}
+
+func newBackupCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return gcm, nil
+}
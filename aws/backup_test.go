@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBackup() *Backup {
+	return &Backup{
+		RunID:     "20220801T120000Z-a1B2c3",
+		CreatedAt: time.Date(2022, 8, 1, 12, 0, 0, 0, time.UTC),
+		AccountID: "123456789012",
+		Schemas:   map[string]BackupSchema{"AWS::Logs::LogGroup": {Version: "00000001"}},
+		Resources: []BackupResource{{
+			Region:        "us-east-1",
+			TypeName:      "AWS::Logs::LogGroup",
+			Identifier:    "my-log-group",
+			SchemaVersion: "00000001",
+			Properties:    json.RawMessage(`{"LogGroupName":"my-log-group"}`),
+		}},
+	}
+}
+
+func TestBackupRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	key := make([]byte, backupKeySize)
+	wrongKey := make([]byte, backupKeySize)
+	wrongKey[0] = 1
+
+	for name, key := range map[string][]byte{"plain": nil, "encrypted": key} {
+		key := key
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "backup.json.gz")
+			require.NoError(t, WriteBackup(path, testBackup(), key))
+
+			backup, err := ReadBackup(path, key)
+			require.NoError(t, err)
+			assert.Equal(t, testBackup(), backup)
+		})
+	}
+
+	t.Run("wrong key", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "backup.json.gz")
+		require.NoError(t, WriteBackup(path, testBackup(), key))
+
+		for _, readKey := range [][]byte{nil, wrongKey} {
+			_, err := ReadBackup(path, readKey)
+			_, ok := errors.Unwrap(err).(InvalidBackupError)
+			assert.True(t, ok, err)
+		}
+	})
+}
+
+func TestLoadBackupKey(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	key := make([]byte, backupKeySize)
+	key[31] = 0xff
+
+	rawPath := filepath.Join(dir, "raw.key")
+	require.NoError(t, ioutil.WriteFile(rawPath, key, 0600))
+	hexPath := filepath.Join(dir, "hex.key")
+	require.NoError(t, ioutil.WriteFile(hexPath, []byte(hex.EncodeToString(key)+"\n"), 0600))
+	shortPath := filepath.Join(dir, "short.key")
+	require.NoError(t, ioutil.WriteFile(shortPath, []byte("abcd"), 0600))
+	// The hex encoding of a 16 byte key is 32 bytes long, like a raw key
+	shortHexPath := filepath.Join(dir, "short-hex.key")
+	require.NoError(t, ioutil.WriteFile(shortHexPath, []byte(hex.EncodeToString(key[:16])), 0600))
+
+	for _, path := range []string{rawPath, hexPath} {
+		loaded, err := LoadBackupKey(path)
+		require.NoError(t, err)
+		assert.Equal(t, key, loaded)
+	}
+
+	for _, path := range []string{shortPath, shortHexPath} {
+		_, err := LoadBackupKey(path)
+		_, ok := errors.Unwrap(err).(InvalidBackupKeyError)
+		assert.True(t, ok, err)
+	}
+}
+
+func TestExtractTags(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, map[string]string{"team": "a"}, extractTags(json.RawMessage(`{"Tags":[{"Key":"team","Value":"a"}]}`)))
+	assert.Equal(t, map[string]string{"team": "a"}, extractTags(json.RawMessage(`{"Tags":{"team":"a"}}`)))
+	assert.Nil(t, extractTags(json.RawMessage(`{"Name":"untagged"}`)))
+}
@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	v1ec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/organizations/organizationsiface"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/redshift/redshiftiface"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+// ClientFactory creates the clients of the region of a config. Tests, and programs that embed cloud-nuke, can replace
+// the SDK clients with mocks or fakes by passing their own factory with WithClientFactory.
+type ClientFactory interface {
+	CloudControl(config aws.Config) CloudControlAPI
+	CloudFormation(config aws.Config) CloudFormationAPI
+	EC2(config aws.Config) EC2API
+	STS(config aws.Config) STSAPI
+	// Services returns the aws-sdk-go (v1) clients of the services that cloud-nuke calls directly
+	Services(config aws.Config) (*ServiceClients, error)
+}
+
+// ServiceClients are the aws-sdk-go (v1) clients of the services that cloud-nuke calls directly, rather than through
+// Cloud Control: to prepare resources, take final snapshots, quarantine resources, handle the CloudNuke:: types, read
+// the account alias and list the accounts of an organization
+type ServiceClients struct {
+	EC2           ec2iface.EC2API
+	ECR           ecriface.ECRAPI
+	ElastiCache   elasticacheiface.ElastiCacheAPI
+	IAM           iamiface.IAMAPI
+	Organizations organizationsiface.OrganizationsAPI
+	RDS           rdsiface.RDSAPI
+	Redshift      redshiftiface.RedshiftAPI
+	Route53       route53iface.Route53API
+	S3            s3iface.S3API
+}
+
+// SDKClientFactory creates the aws-sdk-go-v2 and aws-sdk-go clients, which call AWS
+type SDKClientFactory struct{}
+
+func (SDKClientFactory) CloudControl(config aws.Config) CloudControlAPI {
+	return cloudcontrol.NewFromConfig(config)
+}
+
+func (SDKClientFactory) CloudFormation(config aws.Config) CloudFormationAPI {
+	return cloudformation.NewFromConfig(config)
+}
+
+func (SDKClientFactory) EC2(config aws.Config) EC2API {
+	return ec2.NewFromConfig(config)
+}
+
+func (SDKClientFactory) STS(config aws.Config) STSAPI {
+	return sts.NewFromConfig(config)
+}
+
+func (SDKClientFactory) Services(config aws.Config) (*ServiceClients, error) {
+	sess, err := newSession(config)
+	if err != nil {
+		return nil, err
+	}
+	return &ServiceClients{
+		EC2:           v1ec2.New(sess),
+		ECR:           ecr.New(sess),
+		ElastiCache:   elasticache.New(sess),
+		IAM:           iam.New(sess),
+		Organizations: organizations.New(sess),
+		RDS:           rds.New(sess),
+		Redshift:      redshift.New(sess),
+		Route53:       route53.New(sess),
+		S3:            s3.New(sess),
+	}, nil
+}
+
+// StaticClientFactory returns the same clients for every config, such as mocks in tests. Clients that are left nil
+// make any call to their service panic.
+type StaticClientFactory struct {
+	CloudControlClient   CloudControlAPI
+	CloudFormationClient CloudFormationAPI
+	EC2Client            EC2API
+	STSClient            STSAPI
+	ServiceClients       ServiceClients
+}
+
+func (factory StaticClientFactory) CloudControl(aws.Config) CloudControlAPI {
+	return factory.CloudControlClient
+}
+
+func (factory StaticClientFactory) CloudFormation(aws.Config) CloudFormationAPI {
+	return factory.CloudFormationClient
+}
+
+func (factory StaticClientFactory) EC2(aws.Config) EC2API {
+	return factory.EC2Client
+}
+
+func (factory StaticClientFactory) STS(aws.Config) STSAPI {
+	return factory.STSClient
+}
+
+func (factory StaticClientFactory) Services(aws.Config) (*ServiceClients, error) {
+	clients := factory.ServiceClients
+	return &clients, nil
+}
+
+// clientFactoryKey is the context key of the factory set by WithClientFactory
+type clientFactoryKey struct{}
+
+// WithClientFactory returns a copy of ctx whose scans, nukes and every other call to AWS create their clients with
+// factory. Like the credentials of externalcreds.WithCredentials, the factory travels on the context, so that it
+// reaches every path that creates clients, such as backups, preparation and verification, without threading it through
+// each of them.
+func WithClientFactory(ctx context.Context, factory ClientFactory) context.Context {
+	return context.WithValue(ctx, clientFactoryKey{}, factory)
+}
+
+// clientsFrom returns the factory set on ctx with WithClientFactory, or the SDK one
+func clientsFrom(ctx context.Context) ClientFactory {
+	if factory, ok := ctx.Value(clientFactoryKey{}).(ClientFactory); ok {
+		return factory
+	}
+	return SDKClientFactory{}
+}
@@ -0,0 +1,241 @@
+package aws
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cloudformation_types "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2_types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	v1ec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/golang/mock/gomock"
+	mock_aws "github.com/gruntwork-io/cloud-nuke/aws/mocks/clients"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/externalcreds"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testResourceType is a type without a ResourceHandler or a Preparer, so that it is only ever called through Cloud
+// Control
+const testResourceType = "AWS::Test::Widget"
+
+func TestClientsFromDefaultsToSDKClients(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, SDKClientFactory{}, clientsFrom(context.Background()))
+
+	factory := StaticClientFactory{}
+	assert.Equal(t, factory, clientsFrom(WithClientFactory(context.Background(), factory)))
+}
+
+func TestGetEnabledRegionsWithClientFactory(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockEC2 := mock_aws.NewMockEC2API(ctrl)
+	mockEC2.EXPECT().
+		DescribeRegions(gomock.Any(), &ec2.DescribeRegionsInput{AllRegions: aws.Bool(true)}).
+		Return(&ec2.DescribeRegionsOutput{Regions: []ec2_types.Region{
+			{RegionName: aws.String("us-east-1"), OptInStatus: aws.String(RegionOptInNotRequired)},
+			{RegionName: aws.String("af-south-1"), OptInStatus: aws.String(RegionNotOptedIn)},
+			{RegionName: aws.String("eu-west-1"), OptInStatus: aws.String(RegionOptInNotRequired)},
+			{RegionName: aws.String("ap-east-1"), OptInStatus: aws.String(RegionOptedIn)},
+		}}, nil)
+
+	ctx := WithClientFactory(context.Background(), StaticClientFactory{EC2Client: mockEC2})
+	regions, err := GetEnabledRegionsWithContext(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"us-east-1", "eu-west-1", "ap-east-1"}, regions)
+}
+
+func TestListResourceTypesWithClientFactory(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockCloudFormation := mock_aws.NewMockCloudFormationAPI(ctrl)
+	gomock.InOrder(
+		mockCloudFormation.EXPECT().
+			ListTypes(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&cloudformation.ListTypesOutput{
+				TypeSummaries: []cloudformation_types.TypeSummary{{TypeName: aws.String("AWS::EC2::Instance")}},
+				NextToken:     aws.String("page-2"),
+			}, nil),
+		mockCloudFormation.EXPECT().
+			ListTypes(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&cloudformation.ListTypesOutput{
+				TypeSummaries: []cloudformation_types.TypeSummary{{TypeName: aws.String("AWS::EC2::VPC")}},
+			}, nil),
+	)
+
+	ctx := WithClientFactory(context.Background(), StaticClientFactory{CloudFormationClient: mockCloudFormation})
+	resourceTypes := ListResourceTypesWithContext(ctx)
+	assert.Contains(t, resourceTypes, "AWS::EC2::Instance")
+	assert.Contains(t, resourceTypes, "AWS::EC2::VPC")
+	for _, typeName := range registeredResourceTypes() {
+		assert.Contains(t, resourceTypes, typeName)
+	}
+}
+
+func TestGetAllResourcesWithClientFactory(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockCloudControl := mock_aws.NewMockCloudControlAPI(ctrl)
+	gomock.InOrder(
+		mockCloudControl.EXPECT().
+			ListResources(gomock.Any(), &cloudcontrol.ListResourcesInput{TypeName: aws.String(testResourceType)}, gomock.Any()).
+			Return(&cloudcontrol.ListResourcesOutput{
+				ResourceDescriptions: []types.ResourceDescription{{Identifier: aws.String("widget-a")}},
+				NextToken:            aws.String("page-2"),
+			}, nil),
+		mockCloudControl.EXPECT().
+			ListResources(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&cloudcontrol.ListResourcesOutput{
+				ResourceDescriptions: []types.ResourceDescription{{Identifier: aws.String("widget-b")}},
+			}, nil),
+	)
+
+	ctx := WithClientFactory(context.Background(), StaticClientFactory{CloudControlClient: mockCloudControl})
+	account, err := GetAllResources(ctx, []string{"us-east-1"}, time.Now(), []string{testResourceType}, config.Config{})
+	require.NoError(t, err)
+
+	resources := account.Resources["us-east-1"].Resources
+	require.Len(t, resources, 1)
+	assert.Equal(t, testResourceType, resources[0].TypeName)
+	assert.Equal(t, []string{"widget-a", "widget-b"}, resources[0].Identifiers)
+}
+
+func TestNukeAllResourcesWithClientFactory(t *testing.T) {
+	t.Parallel()
+
+	requestToken := aws.String("request-1")
+	succeeded := &cloudcontrol.GetResourceRequestStatusOutput{ProgressEvent: &types.ProgressEvent{
+		RequestToken:    requestToken,
+		TypeName:        aws.String(testResourceType),
+		Identifier:      aws.String("widget-a"),
+		Operation:       types.OperationDelete,
+		OperationStatus: types.OperationStatusSuccess,
+	}}
+
+	ctrl := gomock.NewController(t)
+	mockCloudControl := mock_aws.NewMockCloudControlAPI(ctrl)
+	mockCloudControl.EXPECT().
+		DeleteResource(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, input *cloudcontrol.DeleteResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.DeleteResourceOutput, error) {
+			assert.Equal(t, testResourceType, aws.ToString(input.TypeName))
+			assert.Equal(t, "widget-a", aws.ToString(input.Identifier))
+			assert.NotEmpty(t, aws.ToString(input.ClientToken))
+			return &cloudcontrol.DeleteResourceOutput{ProgressEvent: &types.ProgressEvent{
+				RequestToken:    requestToken,
+				Operation:       types.OperationDelete,
+				OperationStatus: types.OperationStatusInProgress,
+			}}, nil
+		})
+	// Once by the waiter, then once to report the outcome
+	mockCloudControl.EXPECT().
+		GetResourceRequestStatus(gomock.Any(), &cloudcontrol.GetResourceRequestStatusInput{RequestToken: requestToken}, gomock.Any()).
+		Return(succeeded, nil).
+		Times(2)
+
+	account := &AwsAccountResources{
+		Resources: map[string]AwsRegionResource{
+			"us-east-1": {
+				Resources: []*AwsResource{{TypeName: testResourceType, Identifiers: []string{"widget-a"}}},
+			},
+		},
+	}
+
+	ctx := WithClientFactory(context.Background(), StaticClientFactory{CloudControlClient: mockCloudControl})
+	err := NukeAllResources(ctx, account, []string{"us-east-1"}, NukeOptions{})
+	assert.NoError(t, err)
+}
+
+// Not parallel, as endpoint overrides are global
+func TestServicesResolveNoDefaultEndpointWhenAnyIsOverridden(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	require.NoError(t, externalcreds.SetEndpoints(map[string]string{"cloudcontrol": server.URL}))
+	defer externalcreds.SetEndpoints(nil)
+
+	config := aws.Config{Region: "eu-west-1", Credentials: credentials.NewStaticCredentialsProvider("test", "test", "")}
+	services, err := SDKClientFactory{}.Services(config)
+	require.NoError(t, err)
+
+	calls := map[string]func() error{
+		"ec2": func() error {
+			_, err := services.EC2.DescribeSnapshots(&v1ec2.DescribeSnapshotsInput{})
+			return err
+		},
+		"ecr": func() error {
+			_, err := services.ECR.ListImages(&ecr.ListImagesInput{RepositoryName: awsgo.String("repository")})
+			return err
+		},
+		"elasticache": func() error {
+			_, err := services.ElastiCache.DescribeSnapshots(&elasticache.DescribeSnapshotsInput{})
+			return err
+		},
+		"iam": func() error {
+			_, err := services.IAM.ListAccountAliases(&iam.ListAccountAliasesInput{})
+			return err
+		},
+		"organizations": func() error {
+			_, err := services.Organizations.ListAccounts(&organizations.ListAccountsInput{})
+			return err
+		},
+		"rds": func() error {
+			_, err := services.RDS.DescribeDBSnapshots(&rds.DescribeDBSnapshotsInput{})
+			return err
+		},
+		"redshift": func() error {
+			_, err := services.Redshift.DescribeClusterSnapshots(&redshift.DescribeClusterSnapshotsInput{})
+			return err
+		},
+		"route53": func() error {
+			_, err := services.Route53.ListHostedZones(&route53.ListHostedZonesInput{})
+			return err
+		},
+		"s3": func() error {
+			_, err := services.S3.ListObjectVersions(&s3.ListObjectVersionsInput{Bucket: awsgo.String("bucket")})
+			return err
+		},
+	}
+
+	for service, call := range calls {
+		err := call()
+		var notOverridden externalcreds.EndpointNotOverriddenError
+		require.True(t, stderrors.As(err, &notOverridden), "%s: %v", service, err)
+		assert.Equal(t, service, notOverridden.Service)
+	}
+	assert.Equal(t, 0, requests)
+
+	// Overridden services call the override
+	require.NoError(t, externalcreds.SetEndpoints(map[string]string{"cloudcontrol": server.URL, "s3": server.URL}))
+	services, err = SDKClientFactory{}.Services(config)
+	require.NoError(t, err)
+	require.Error(t, calls["s3"]())
+	assert.NotEqual(t, 0, requests)
+}
@@ -0,0 +1,109 @@
+package aws
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	stderrors "errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/util"
+)
+
+const (
+	// maxDeleteAttempts is the number of times a deletion request is submitted before giving up
+	maxDeleteAttempts = 4
+	// deleteRetryBackoff is multiplied by the attempt number to get the wait between submissions
+	deleteRetryBackoff = 2 * time.Second
+)
+
+// clientToken derives the ClientToken of a deletion request. Cloud Control treats requests with the same token as
+// the same request, so submitting the deletion again, after a network error or when resuming an interrupted run,
+// returns the original request instead of starting a second one. The attempt is bumped each time a deletion fails,
+// so that retrying a failed deletion starts a new request rather than returning the failed one.
+func clientToken(runID, region, typeName, identifier string, attempt int) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%s\x00%s\x00%s\x00%d", runID, region, typeName, identifier, attempt)))
+	return hex.EncodeToString(hash[:])
+}
+
+// ClientToken returns the ClientToken of the next deletion request of the resource, and records it in the journal.
+// Without a journal there is nothing to resume from, so the token is only stable across the retries of this call.
+func (run *Run) ClientToken(region, typeName, identifier string) string {
+	if run == nil {
+		return clientToken(util.UniqueID(), region, typeName, identifier, 0)
+	}
+
+	run.mutex.Lock()
+	attempt := 0
+	if entry := run.find(region, typeName, identifier); entry != nil {
+		attempt = entry.Attempt
+	}
+	run.mutex.Unlock()
+
+	token := clientToken(run.ID, region, typeName, identifier, attempt)
+	run.record(region, typeName, identifier, func(entry *RunEntry) {
+		entry.ClientToken = token
+	})
+	return token
+}
+
+// submitDeletion calls DeleteResource, retrying errors that may be transient. Every attempt uses the same client
+// token, so a request that reached Cloud Control before the error is not submitted twice. A resource that no longer
+// exists is reported with a nil output and a nil error.
+func submitDeletion(ctx context.Context, svc CloudControlAPI, input *cloudcontrol.DeleteResourceInput) (*cloudcontrol.DeleteResourceOutput, error) {
+	var lastErr error
+	for attempt := 1; attempt <= maxDeleteAttempts; attempt++ {
+		// Deliberately not cancellable: once started, the submission must complete so its request token is journaled
+		submitCtx, cancelSubmit := detachedContext()
+		output, err := svc.DeleteResource(submitCtx, input)
+		cancelSubmit()
+
+		if err == nil {
+			return output, nil
+		}
+
+		var notFound *types.ResourceNotFoundException
+		if stderrors.As(err, &notFound) {
+			return nil, nil
+		}
+
+		lastErr = err
+		if !isRetryableDeleteError(err) || attempt == maxDeleteAttempts {
+			break
+		}
+
+		logging.Logger.Debugf("Retrying deletion of %s %s after error: %s", aws.ToString(input.TypeName), aws.ToString(input.Identifier), err)
+		sleepWithContext(ctx, deleteRetryBackoff*time.Duration(attempt))
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, lastErr
+}
+
+// isRetryableDeleteError returns true for errors after which submitting the deletion again, with the same client
+// token, can succeed. ClientTokenConflictException is not retried: it means the token was used for another request.
+func isRetryableDeleteError(err error) bool {
+	var (
+		concurrentOperation *types.ConcurrentOperationException
+		resourceConflict    *types.ResourceConflictException
+		throttling          *types.ThrottlingException
+		serviceInternal     *types.ServiceInternalErrorException
+		networkFailure      *types.NetworkFailureException
+		handlerInternal     *types.HandlerInternalFailureException
+		netErr              net.Error
+	)
+	return stderrors.As(err, &concurrentOperation) ||
+		stderrors.As(err, &resourceConflict) ||
+		stderrors.As(err, &throttling) ||
+		stderrors.As(err, &serviceInternal) ||
+		stderrors.As(err, &networkFailure) ||
+		stderrors.As(err, &handlerInternal) ||
+		stderrors.As(err, &netErr)
+}
@@ -0,0 +1,64 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunClientToken(t *testing.T) {
+	t.Parallel()
+
+	run := NewRun(filepath.Join(t.TempDir(), "run.json"))
+	region, typeName, identifier := "us-east-1", "AWS::Logs::LogGroup", "my-log-group"
+
+	token := run.ClientToken(region, typeName, identifier)
+	assert.Len(t, token, 64)
+	assert.Equal(t, token, run.ClientToken(region, typeName, identifier), "retries reuse the token")
+
+	// A resumed run reuses the token of a submission that never reached a terminal state
+	require.NoError(t, run.Flush())
+	loaded, err := LoadRun(run.Path())
+	require.NoError(t, err)
+	assert.Equal(t, token, loaded.ClientToken(region, typeName, identifier))
+	assert.Equal(t, token, loaded.Entries[0].ClientToken)
+
+	// Retrying a failed deletion needs a new request
+	require.NoError(t, loaded.Transition(region, typeName, identifier, func(entry *RunEntry) {
+		entry.update(&types.ProgressEvent{OperationStatus: types.OperationStatusFailed})
+		entry.update(&types.ProgressEvent{OperationStatus: types.OperationStatusFailed})
+	}))
+	assert.Equal(t, 1, loaded.Entries[0].Attempt)
+	assert.NotEqual(t, token, loaded.ClientToken(region, typeName, identifier))
+
+	assert.NotEqual(t, token, NewRun(filepath.Join(t.TempDir(), "other.json")).ClientToken(region, typeName, identifier), "tokens are scoped to the run")
+}
+
+func TestIsRetryableDeleteError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		err       error
+		retryable bool
+	}{
+		{&types.ThrottlingException{}, true},
+		{fmt.Errorf("operation error: %w", &types.ConcurrentOperationException{}), true},
+		{&types.NetworkFailureException{}, true},
+		{&types.ClientTokenConflictException{}, false},
+		{&types.InvalidRequestException{}, false},
+		{errors.New("AccessDenied"), false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(fmt.Sprintf("%T", tt.err), func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.retryable, isRetryableDeleteError(tt.err))
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// newEC2Client returns the aws-sdk-go (v1) EC2 client of the region of config, created by the factory of ctx
+func newEC2Client(ctx context.Context, config aws.Config) (ec2iface.EC2API, error) {
+	services, err := clientsFrom(ctx).Services(config)
+	if err != nil {
+		return nil, err
+	}
+	return services.EC2, nil
+}
+
+// EBSSnapshots - represents all EBS snapshots owned by the account
+type EBSSnapshots struct{}
+
+// TypeName - the name of the resource type, as passed to --resource-type
+func (snapshots EBSSnapshots) TypeName() string {
+	return CustomResourceTypePrefix + "EC2::Snapshot"
+}
+
+// List - returns the IDs of the snapshots owned by the account, except for the final snapshots taken by cloud-nuke,
+// which are handled by ExpiredFinalSnapshots
+func (snapshots EBSSnapshots) List(ctx context.Context, config aws.Config) ([]string, error) {
+	svc, err := newEC2Client(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	return listEBSSnapshots(ctx, svc)
+}
+
+// Delete - deletes a snapshot
+func (snapshots EBSSnapshots) Delete(ctx context.Context, config aws.Config, identifier string) error {
+	svc, err := newEC2Client(ctx, config)
+	if err != nil {
+		return err
+	}
+	_, err = svc.DeleteSnapshotWithContext(ctx, &ec2.DeleteSnapshotInput{SnapshotId: awsgo.String(identifier)})
+	return errors.WithStackTrace(err)
+}
+
+func listEBSSnapshots(ctx context.Context, svc ec2iface.EC2API) ([]string, error) {
+	snapshotIds := []string{}
+	input := &ec2.DescribeSnapshotsInput{
+		OwnerIds: awsgo.StringSlice([]string{"self"}),
+	}
+	err := svc.DescribeSnapshotsPagesWithContext(ctx, input, func(page *ec2.DescribeSnapshotsOutput, lastPage bool) bool {
+		for _, snapshot := range page.Snapshots {
+			if _, ok := ec2TagMap(snapshot.Tags)[SnapshotRunIDTagKey]; ok {
+				// Final snapshots taken by cloud-nuke are kept until they expire
+				continue
+			}
+			snapshotIds = append(snapshotIds, awsgo.StringValue(snapshot.SnapshotId))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return snapshotIds, nil
+}
+
+// AMIs - represents all AMIs owned by the account
+type AMIs struct{}
+
+// TypeName - the name of the resource type, as passed to --resource-type
+func (amis AMIs) TypeName() string {
+	return CustomResourceTypePrefix + "EC2::Image"
+}
+
+// List - returns the IDs of the AMIs owned by the account
+func (amis AMIs) List(ctx context.Context, config aws.Config) ([]string, error) {
+	svc, err := newEC2Client(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	return listAMIs(ctx, svc)
+}
+
+// Delete - deregisters an AMI. Its backing snapshots are handled by EBSSnapshots.
+func (amis AMIs) Delete(ctx context.Context, config aws.Config, identifier string) error {
+	svc, err := newEC2Client(ctx, config)
+	if err != nil {
+		return err
+	}
+	_, err = svc.DeregisterImageWithContext(ctx, &ec2.DeregisterImageInput{ImageId: awsgo.String(identifier)})
+	return errors.WithStackTrace(err)
+}
+
+func listAMIs(ctx context.Context, svc ec2iface.EC2API) ([]string, error) {
+	imageIds := []string{}
+	input := &ec2.DescribeImagesInput{
+		Owners: awsgo.StringSlice([]string{"self"}),
+	}
+	err := svc.DescribeImagesPagesWithContext(ctx, input, func(page *ec2.DescribeImagesOutput, lastPage bool) bool {
+		for _, image := range page.Images {
+			imageIds = append(imageIds, awsgo.StringValue(image.ImageId))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return imageIds, nil
+}
+
+// DefaultVPCs - represents the default VPC of a region, together with the subnets and internet gateway that AWS
+// creates with it
+type DefaultVPCs struct{}
+
+// TypeName - the name of the resource type, as passed to --resource-type
+func (vpcs DefaultVPCs) TypeName() string {
+	return CustomResourceTypePrefix + "EC2::DefaultVPC"
+}
+
+// List - returns the ID of the default VPC, if the region has one
+func (vpcs DefaultVPCs) List(ctx context.Context, config aws.Config) ([]string, error) {
+	svc, err := newEC2Client(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	return listDefaultVPCs(ctx, svc)
+}
+
+// Delete - deletes the default VPC after removing the subnets and internet gateway that would otherwise block it.
+// The default security group, network ACL and route table are deleted together with the VPC.
+func (vpcs DefaultVPCs) Delete(ctx context.Context, config aws.Config, identifier string) error {
+	svc, err := newEC2Client(ctx, config)
+	if err != nil {
+		return err
+	}
+	return deleteDefaultVPC(ctx, svc, identifier)
+}
+
+func listDefaultVPCs(ctx context.Context, svc ec2iface.EC2API) ([]string, error) {
+	output, err := svc.DescribeVpcsWithContext(ctx, &ec2.DescribeVpcsInput{
+		Filters: []*ec2.Filter{
+			{Name: awsgo.String("isDefault"), Values: awsgo.StringSlice([]string{"true"})},
+		},
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	vpcIds := []string{}
+	for _, vpc := range output.Vpcs {
+		vpcIds = append(vpcIds, awsgo.StringValue(vpc.VpcId))
+	}
+	return vpcIds, nil
+}
+
+func deleteDefaultVPC(ctx context.Context, svc ec2iface.EC2API, vpcId string) error {
+	gateways, err := svc.DescribeInternetGatewaysWithContext(ctx, &ec2.DescribeInternetGatewaysInput{
+		Filters: []*ec2.Filter{
+			{Name: awsgo.String("attachment.vpc-id"), Values: awsgo.StringSlice([]string{vpcId})},
+		},
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	for _, gateway := range gateways.InternetGateways {
+		_, err := svc.DetachInternetGatewayWithContext(ctx, &ec2.DetachInternetGatewayInput{
+			InternetGatewayId: gateway.InternetGatewayId,
+			VpcId:             awsgo.String(vpcId),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		_, err = svc.DeleteInternetGatewayWithContext(ctx, &ec2.DeleteInternetGatewayInput{
+			InternetGatewayId: gateway.InternetGatewayId,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	subnets, err := svc.DescribeSubnetsWithContext(ctx, &ec2.DescribeSubnetsInput{
+		Filters: []*ec2.Filter{
+			{Name: awsgo.String("vpc-id"), Values: awsgo.StringSlice([]string{vpcId})},
+		},
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	for _, subnet := range subnets.Subnets {
+		_, err := svc.DeleteSubnetWithContext(ctx, &ec2.DeleteSubnetInput{SubnetId: subnet.SubnetId})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	_, err = svc.DeleteVpcWithContext(ctx, &ec2.DeleteVpcInput{VpcId: awsgo.String(vpcId)})
+	return errors.WithStackTrace(err)
+}
@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	mock_ec2iface "github.com/gruntwork-io/cloud-nuke/aws/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomResourceTypesAreRegistered(t *testing.T) {
+	t.Parallel()
+
+	for _, handler := range []ResourceHandler{EBSSnapshots{}, AMIs{}, DefaultVPCs{}} {
+		registered, ok := resourceHandlerFor(handler.TypeName())
+		require.True(t, ok, handler.TypeName())
+		assert.Equal(t, handler, registered)
+		assert.Contains(t, registeredResourceTypes(), handler.TypeName())
+	}
+
+	_, ok := resourceHandlerFor("AWS::Logs::LogGroup")
+	assert.False(t, ok)
+}
+
+func TestListEBSSnapshots(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockEC2 := mock_ec2iface.NewMockEC2API(ctrl)
+
+	mockEC2.EXPECT().
+		DescribeSnapshotsPagesWithContext(gomock.Any(), &ec2.DescribeSnapshotsInput{OwnerIds: awsgo.StringSlice([]string{"self"})}, gomock.Any()).
+		DoAndReturn(func(ctx awsgo.Context, input *ec2.DescribeSnapshotsInput, fn func(*ec2.DescribeSnapshotsOutput, bool) bool, opts ...request.Option) error {
+			fn(&ec2.DescribeSnapshotsOutput{Snapshots: []*ec2.Snapshot{{SnapshotId: awsgo.String("snap-1")}}}, false)
+			fn(&ec2.DescribeSnapshotsOutput{Snapshots: []*ec2.Snapshot{
+				{SnapshotId: awsgo.String("snap-2")},
+				{SnapshotId: awsgo.String("snap-final"), Tags: []*ec2.Tag{{Key: awsgo.String(SnapshotRunIDTagKey), Value: awsgo.String("run-1")}}},
+			}}, true)
+			return nil
+		})
+
+	snapshotIds, err := listEBSSnapshots(context.Background(), mockEC2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"snap-1", "snap-2"}, snapshotIds)
+}
+
+func TestListAMIs(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockEC2 := mock_ec2iface.NewMockEC2API(ctrl)
+
+	mockEC2.EXPECT().
+		DescribeImagesPagesWithContext(gomock.Any(), &ec2.DescribeImagesInput{Owners: awsgo.StringSlice([]string{"self"})}, gomock.Any()).
+		DoAndReturn(func(ctx awsgo.Context, input *ec2.DescribeImagesInput, fn func(*ec2.DescribeImagesOutput, bool) bool, opts ...request.Option) error {
+			fn(&ec2.DescribeImagesOutput{Images: []*ec2.Image{{ImageId: awsgo.String("ami-1")}}}, false)
+			fn(&ec2.DescribeImagesOutput{Images: []*ec2.Image{{ImageId: awsgo.String("ami-2")}}}, true)
+			return nil
+		})
+
+	imageIds, err := listAMIs(context.Background(), mockEC2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ami-1", "ami-2"}, imageIds)
+}
+
+func TestDeleteDefaultVPC(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockEC2 := mock_ec2iface.NewMockEC2API(ctrl)
+	vpcId := "vpc-default"
+
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeInternetGatewaysWithContext(gomock.Any(), gomock.Any()).
+			Return(&ec2.DescribeInternetGatewaysOutput{InternetGateways: []*ec2.InternetGateway{{InternetGatewayId: awsgo.String("igw-1")}}}, nil),
+		mockEC2.EXPECT().DetachInternetGatewayWithContext(gomock.Any(), &ec2.DetachInternetGatewayInput{InternetGatewayId: awsgo.String("igw-1"), VpcId: awsgo.String(vpcId)}).
+			Return(&ec2.DetachInternetGatewayOutput{}, nil),
+		mockEC2.EXPECT().DeleteInternetGatewayWithContext(gomock.Any(), &ec2.DeleteInternetGatewayInput{InternetGatewayId: awsgo.String("igw-1")}).
+			Return(&ec2.DeleteInternetGatewayOutput{}, nil),
+		mockEC2.EXPECT().DescribeSubnetsWithContext(gomock.Any(), gomock.Any()).
+			Return(&ec2.DescribeSubnetsOutput{Subnets: []*ec2.Subnet{{SubnetId: awsgo.String("subnet-1")}, {SubnetId: awsgo.String("subnet-2")}}}, nil),
+		mockEC2.EXPECT().DeleteSubnetWithContext(gomock.Any(), &ec2.DeleteSubnetInput{SubnetId: awsgo.String("subnet-1")}).
+			Return(&ec2.DeleteSubnetOutput{}, nil),
+		mockEC2.EXPECT().DeleteSubnetWithContext(gomock.Any(), &ec2.DeleteSubnetInput{SubnetId: awsgo.String("subnet-2")}).
+			Return(&ec2.DeleteSubnetOutput{}, nil),
+		mockEC2.EXPECT().DeleteVpcWithContext(gomock.Any(), &ec2.DeleteVpcInput{VpcId: awsgo.String(vpcId)}).
+			Return(&ec2.DeleteVpcOutput{}, nil),
+	)
+
+	require.NoError(t, deleteDefaultVPC(context.Background(), mockEC2, vpcId))
+}
@@ -0,0 +1,169 @@
+package aws
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// CustomResourceTypePrefix prefixes the names of the resource types that only exist in cloud-nuke, because
+// CloudFormation has no equivalent type for them. These types are opt-in: they are only nuked when named with
+// --resource-type.
+const CustomResourceTypePrefix = "CloudNuke::"
+
+// isOptInResourceType returns true if the type is only nuked when it is selected by name, rather than as part of every
+// type or of the types left after exclusions
+func isOptInResourceType(typeName string) bool {
+	return strings.HasPrefix(typeName, CustomResourceTypePrefix)
+}
+
+// ResourceHandler lists and deletes the resources of a single type through the AWS SDK. It lets cloud-nuke handle
+// types that Cloud Control can't, such as EBS snapshots or AMIs. Resource types without a registered handler are
+// listed and deleted through Cloud Control.
+type ResourceHandler interface {
+	// TypeName is the resource type the handler is registered for, as passed to --resource-type
+	TypeName() string
+	// List returns the identifiers of the resources in the region of the given config
+	List(ctx context.Context, config aws.Config) ([]string, error)
+	// Delete deletes a single resource, returning once it is gone
+	Delete(ctx context.Context, config aws.Config, identifier string) error
+}
+
+// ResourceDescriber is implemented by handlers that can also return the properties of a single resource, as a JSON
+// document
+type ResourceDescriber interface {
+	Describe(ctx context.Context, config aws.Config, identifier string) (string, error)
+}
+
+var (
+	resourceHandlers      = map[string]ResourceHandler{}
+	resourceHandlersMutex sync.RWMutex
+)
+
+func init() {
+	RegisterResourceHandler(EBSSnapshots{})
+	RegisterResourceHandler(AMIs{})
+	RegisterResourceHandler(DefaultVPCs{})
+	RegisterResourceHandler(ExpiredFinalSnapshots{})
+}
+
+// RegisterResourceHandler makes a handler responsible for listing and deleting the resources of its type instead of
+// Cloud Control, replacing any handler previously registered for that type
+func RegisterResourceHandler(handler ResourceHandler) {
+	resourceHandlersMutex.Lock()
+	defer resourceHandlersMutex.Unlock()
+	resourceHandlers[handler.TypeName()] = handler
+}
+
+// resourceHandlerFor returns the handler registered for the type, or false if the type is handled by Cloud Control
+func resourceHandlerFor(typeName string) (ResourceHandler, bool) {
+	resourceHandlersMutex.RLock()
+	defer resourceHandlersMutex.RUnlock()
+	handler, ok := resourceHandlers[typeName]
+	return handler, ok
+}
+
+// registeredResourceTypes returns the sorted names of the types that have a registered handler
+func registeredResourceTypes() []string {
+	resourceHandlersMutex.RLock()
+	defer resourceHandlersMutex.RUnlock()
+
+	typeNames := []string{}
+	for typeName := range resourceHandlers {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+	return typeNames
+}
+
+// listResources returns the identifiers of the resources of the given type, using the registered handler if there is
+// one and Cloud Control otherwise
+func listResources(ctx context.Context, config aws.Config, svc CloudControlAPI, typeName string) ([]string, error) {
+	if handler, ok := resourceHandlerFor(typeName); ok {
+		return handler.List(ctx, config)
+	}
+
+	identifiers := []string{}
+	paginator := cloudcontrol.NewListResourcesPaginator(svc, &cloudcontrol.ListResourcesInput{
+		TypeName: aws.String(typeName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		for _, resourceDescription := range output.ResourceDescriptions {
+			logging.Logger.Debugf("Found resource (%s) with properties: %+v\n", aws.ToString(resourceDescription.Identifier), aws.ToString(resourceDescription.Properties))
+			identifiers = append(identifiers, aws.ToString(resourceDescription.Identifier))
+		}
+	}
+	return identifiers, nil
+}
+
+// describeResource returns the properties of a resource as a JSON document, using the registered handler if it
+// implements ResourceDescriber and Cloud Control otherwise
+func describeResource(ctx context.Context, config aws.Config, svc CloudControlAPI, typeName, identifier string) (string, error) {
+	if handler, ok := resourceHandlerFor(typeName); ok {
+		describer, ok := handler.(ResourceDescriber)
+		if !ok {
+			return "", errors.WithStackTrace(ResourceNotDescribableError{TypeName: typeName})
+		}
+		return describer.Describe(ctx, config, identifier)
+	}
+
+	output, err := svc.GetResource(ctx, &cloudcontrol.GetResourceInput{
+		TypeName:   aws.String(typeName),
+		Identifier: aws.String(identifier),
+	})
+	if err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	return aws.ToString(output.ResourceDescription.Properties), nil
+}
+
+// nukeWithHandler deletes a resource through its registered handler. Unlike Cloud Control deletions there is no
+// request token to track, so the deletion always completes before returning. Handlers delete their resources on their
+// own terms, so no final snapshot is taken and no preparation step is run.
+func nukeWithHandler(ctx context.Context, handler ResourceHandler, config aws.Config, identifier, region string, opts NukeOptions) AwsResourceResult {
+	result := AwsResourceResult{
+		TypeName:   handler.TypeName(),
+		Identifier: identifier,
+		Operation:  "DELETE",
+	}
+
+	if ctx.Err() != nil {
+		// As with nukeAsync, the resource stays planned in the journal, so a resumed run picks it up
+		result.OperationStatus = "Skipped"
+		result.StatusMessage = "Interrupted before the deletion was submitted"
+		return result
+	}
+
+	logging.Logger.Infof("Nuking resource type: %s with identifier: %s", handler.TypeName(), identifier)
+
+	opts.Run.record(region, handler.TypeName(), identifier, func(entry *RunEntry) {
+		entry.State = RunEntrySubmitted
+	})
+
+	if err := handler.Delete(ctx, config, identifier); err != nil {
+		result.OperationStatus = "FAILED"
+		result.StatusMessage = truncateText(err.Error(), 60)
+		result.Error = err
+		opts.Run.record(region, handler.TypeName(), identifier, func(entry *RunEntry) {
+			entry.fail(err)
+		})
+		return result
+	}
+
+	result.OperationStatus = "SUCCESS"
+	opts.Run.record(region, handler.TypeName(), identifier, func(entry *RunEntry) {
+		entry.State = RunEntrySucceeded
+		entry.OperationStatus = "SUCCESS"
+	})
+	return result
+}
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/gruntwork-io/cloud-nuke/config"
@@ -30,13 +31,18 @@ func ExtractResourcesForPrinting(account *AwsAccountResources) []string {
 	return resources
 }
 
-func ensureValidResourceTypes(resourceTypes []string) ([]string, error) {
+func ensureValidResourceTypes(ctx context.Context, resourceTypes []string) ([]string, error) {
+	if len(resourceTypes) == 0 {
+		return resourceTypes, nil
+	}
+
+	allResourceTypes := ListResourceTypesWithContext(ctx)
 	invalidresourceTypes := []string{}
 	for _, resourceType := range resourceTypes {
 		if resourceType == "all" {
 			continue
 		}
-		if !IsValidResourceType(resourceType, ListResourceTypes()) {
+		if !IsValidResourceType(resourceType, allResourceTypes) {
 			invalidresourceTypes = append(invalidresourceTypes, resourceType)
 		}
 	}
@@ -49,27 +55,31 @@ func ensureValidResourceTypes(resourceTypes []string) ([]string, error) {
 }
 
 // HandleResourceTypeSelections accepts a slice of target resourceTypes and a slice of resourceTypes to exclude. It filters
-// any excluded or invalid types from target resourceTypes then returns the filtered slice
+// any excluded or invalid types from target resourceTypes then returns the filtered slice. Valid types are listed with
+// the clients of ctx. Opt-in types, such as CloudNuke::EC2::Image, are only returned when they are targeted by name.
 func HandleResourceTypeSelections(
-	includeResourceTypes, excludeResourceTypes []string,
+	ctx context.Context, includeResourceTypes, excludeResourceTypes []string,
 ) ([]string, error) {
 	if len(includeResourceTypes) > 0 && len(excludeResourceTypes) > 0 {
 		return []string{}, ResourceTypeAndExcludeFlagsBothPassedError{}
 	}
 
 	if len(includeResourceTypes) > 0 {
-		return ensureValidResourceTypes(includeResourceTypes)
+		return ensureValidResourceTypes(ctx, includeResourceTypes)
 	}
 
 	// Handle exclude resource types by going through the list of all types and only include those that are not
 	// mentioned in the exclude list.
-	validExcludeResourceTypes, err := ensureValidResourceTypes(excludeResourceTypes)
+	validExcludeResourceTypes, err := ensureValidResourceTypes(ctx, excludeResourceTypes)
 	if err != nil {
 		return []string{}, err
 	}
 
 	resourceTypes := []string{}
-	for _, resourceType := range ListResourceTypes() {
+	for _, resourceType := range ListResourceTypesWithContext(ctx) {
+		if isOptInResourceType(resourceType) {
+			continue
+		}
 		if !collections.ListContainsElement(validExcludeResourceTypes, resourceType) {
 			resourceTypes = append(resourceTypes, resourceType)
 		}
@@ -77,7 +87,7 @@ func HandleResourceTypeSelections(
 	return resourceTypes, nil
 }
 
-func InspectResources(q *Query) (*AwsAccountResources, error) {
+func InspectResources(ctx context.Context, q *Query) (*AwsAccountResources, error) {
 	// Log which resource types will be inspected
 	logging.Logger.Info("The following resource types will be inspected:")
 	if len(q.ResourceTypes) > 0 {
@@ -85,11 +95,11 @@ func InspectResources(q *Query) (*AwsAccountResources, error) {
 			logging.Logger.Infof("- %s", resourceType)
 		}
 	} else {
-		for _, resourceType := range ListResourceTypes() {
+		for _, resourceType := range ListResourceTypesWithContext(ctx) {
 			logging.Logger.Infof("- %s", resourceType)
 		}
 	}
 
 	// NOTE: The inspect functionality currently does not support config file, so we short circuit the logic with an empty struct.
-	return GetAllResources(q.Regions, q.ExcludeAfter, q.ResourceTypes, config.Config{})
+	return GetAllResources(ctx, q.Regions, q.ExcludeAfter, q.ResourceTypes, config.Config{})
 }
@@ -1,12 +1,35 @@
 package aws
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cloudformation_types "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/golang/mock/gomock"
+	mock_aws "github.com/gruntwork-io/cloud-nuke/aws/mocks/clients"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+// withResourceTypes returns a context whose CloudFormation client lists the given resource types
+func withResourceTypes(t *testing.T, typeNames ...string) context.Context {
+	summaries := []cloudformation_types.TypeSummary{}
+	for _, typeName := range typeNames {
+		summaries = append(summaries, cloudformation_types.TypeSummary{TypeName: aws.String(typeName)})
+	}
+
+	ctrl := gomock.NewController(t)
+	mockCloudFormation := mock_aws.NewMockCloudFormationAPI(ctrl)
+	mockCloudFormation.EXPECT().
+		ListTypes(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&cloudformation.ListTypesOutput{TypeSummaries: summaries}, nil).
+		AnyTimes()
+	return WithClientFactory(context.Background(), StaticClientFactory{CloudFormationClient: mockCloudFormation})
+}
+
 func TestHandleResourceTypeSelectionsRejectsInvalid(t *testing.T) {
 	type TestCase struct {
 		Name                 string
@@ -26,9 +49,11 @@ func TestHandleResourceTypeSelectionsRejectsInvalid(t *testing.T) {
 		},
 	}
 
+	ctx := withResourceTypes(t, "AWS::EC2::Instance")
+
 	for _, tc := range testCases {
 		t.Run(tc.Name, func(t *testing.T) {
-			_, err := HandleResourceTypeSelections(tc.ResourceTypes, tc.ExcludeResourceTypes)
+			_, err := HandleResourceTypeSelections(ctx, tc.ResourceTypes, tc.ExcludeResourceTypes)
 			require.Error(t, err)
 			require.ErrorAs(t, err, &tc.Error)
 		})
@@ -55,9 +80,11 @@ func TestHandleResourceTypeSelectionsRejectsConflictingParams(t *testing.T) {
 		},
 	}
 
+	ctx := withResourceTypes(t, "AWS::EC2::Instance")
+
 	for _, tc := range testCases {
 		t.Run(tc.Name, func(t *testing.T) {
-			_, err := HandleResourceTypeSelections(tc.ResourceTypes, tc.ExcludeResourceTypes)
+			_, err := HandleResourceTypeSelections(ctx, tc.ResourceTypes, tc.ExcludeResourceTypes)
 			require.Error(t, err)
 			require.ErrorAs(t, err, &tc.Error)
 		})
@@ -75,14 +102,16 @@ func TestHandleResourceTypeSelectionsFiltering(t *testing.T) {
 
 	testCases := []TestCase{{
 		Name:                 "Valid resource types are accepted",
-		ResourceTypes:        []string{"ec2", "vpc"},
+		ResourceTypes:        []string{"AWS::EC2::Instance", "AWS::EC2::VPC"},
 		ExcludeResourceTypes: []string{},
-		Want:                 []string{"ec2", "vpc"},
+		Want:                 []string{"AWS::EC2::Instance", "AWS::EC2::VPC"},
 	},
 	}
+	ctx := withResourceTypes(t, "AWS::EC2::Instance", "AWS::EC2::VPC")
+
 	for _, tc := range testCases {
 		t.Run(tc.Name, func(t *testing.T) {
-			got, err := HandleResourceTypeSelections(tc.ResourceTypes, tc.ExcludeResourceTypes)
+			got, err := HandleResourceTypeSelections(ctx, tc.ResourceTypes, tc.ExcludeResourceTypes)
 			require.NoError(t, err)
 			if !reflect.DeepEqual(got, tc.Want) {
 				t.Logf("%s: Expected %v but got %v", tc.Name, tc.Want, got)
@@ -91,3 +120,38 @@ func TestHandleResourceTypeSelectionsFiltering(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleResourceTypeSelectionsOnlyIncludesOptInTypesByName(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockCloudFormation := mock_aws.NewMockCloudFormationAPI(ctrl)
+	mockCloudFormation.EXPECT().
+		ListTypes(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&cloudformation.ListTypesOutput{
+			TypeSummaries: []cloudformation_types.TypeSummary{
+				{TypeName: aws.String("AWS::EC2::Instance")},
+				{TypeName: aws.String("AWS::Logs::LogGroup")},
+			},
+		}, nil).
+		AnyTimes()
+	ctx := WithClientFactory(context.Background(), StaticClientFactory{CloudFormationClient: mockCloudFormation})
+
+	imageType := AMIs{}.TypeName()
+	assert.Contains(t, ListResourceTypesWithContext(ctx), imageType)
+
+	resourceTypes, err := HandleResourceTypeSelections(ctx, nil, nil)
+	require.NoError(t, err)
+	assert.Contains(t, resourceTypes, "AWS::EC2::Instance")
+	for _, resourceType := range resourceTypes {
+		assert.False(t, isOptInResourceType(resourceType), resourceType)
+	}
+
+	resourceTypes, err = HandleResourceTypeSelections(ctx, nil, []string{"AWS::EC2::Instance"})
+	require.NoError(t, err)
+	assert.NotContains(t, resourceTypes, imageType)
+
+	resourceTypes, err = HandleResourceTypeSelections(ctx, []string{imageType}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{imageType}, resourceTypes)
+}
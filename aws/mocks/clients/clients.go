@@ -0,0 +1,348 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: clients.go
+
+// Package mock_aws is a generated GoMock package.
+package mock_aws
+
+import (
+	context "context"
+	reflect "reflect"
+
+	cloudcontrol "github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	cloudformation "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	ec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	sts "github.com/aws/aws-sdk-go-v2/service/sts"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCloudControlAPI is a mock of CloudControlAPI interface.
+type MockCloudControlAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCloudControlAPIMockRecorder
+}
+
+// MockCloudControlAPIMockRecorder is the mock recorder for MockCloudControlAPI.
+type MockCloudControlAPIMockRecorder struct {
+	mock *MockCloudControlAPI
+}
+
+// NewMockCloudControlAPI creates a new mock instance.
+func NewMockCloudControlAPI(ctrl *gomock.Controller) *MockCloudControlAPI {
+	mock := &MockCloudControlAPI{ctrl: ctrl}
+	mock.recorder = &MockCloudControlAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCloudControlAPI) EXPECT() *MockCloudControlAPIMockRecorder {
+	return m.recorder
+}
+
+// CancelResourceRequest mocks base method.
+func (m *MockCloudControlAPI) CancelResourceRequest(ctx context.Context, params *cloudcontrol.CancelResourceRequestInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.CancelResourceRequestOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelResourceRequest", varargs...)
+	ret0, _ := ret[0].(*cloudcontrol.CancelResourceRequestOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelResourceRequest indicates an expected call of CancelResourceRequest.
+func (mr *MockCloudControlAPIMockRecorder) CancelResourceRequest(ctx, params interface{}, optFns ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, params}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelResourceRequest", reflect.TypeOf((*MockCloudControlAPI)(nil).CancelResourceRequest), varargs...)
+}
+
+// CreateResource mocks base method.
+func (m *MockCloudControlAPI) CreateResource(ctx context.Context, params *cloudcontrol.CreateResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.CreateResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateResource", varargs...)
+	ret0, _ := ret[0].(*cloudcontrol.CreateResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateResource indicates an expected call of CreateResource.
+func (mr *MockCloudControlAPIMockRecorder) CreateResource(ctx, params interface{}, optFns ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, params}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResource", reflect.TypeOf((*MockCloudControlAPI)(nil).CreateResource), varargs...)
+}
+
+// DeleteResource mocks base method.
+func (m *MockCloudControlAPI) DeleteResource(ctx context.Context, params *cloudcontrol.DeleteResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.DeleteResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteResource", varargs...)
+	ret0, _ := ret[0].(*cloudcontrol.DeleteResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteResource indicates an expected call of DeleteResource.
+func (mr *MockCloudControlAPIMockRecorder) DeleteResource(ctx, params interface{}, optFns ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, params}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResource", reflect.TypeOf((*MockCloudControlAPI)(nil).DeleteResource), varargs...)
+}
+
+// GetResource mocks base method.
+func (m *MockCloudControlAPI) GetResource(ctx context.Context, params *cloudcontrol.GetResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.GetResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetResource", varargs...)
+	ret0, _ := ret[0].(*cloudcontrol.GetResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResource indicates an expected call of GetResource.
+func (mr *MockCloudControlAPIMockRecorder) GetResource(ctx, params interface{}, optFns ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, params}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResource", reflect.TypeOf((*MockCloudControlAPI)(nil).GetResource), varargs...)
+}
+
+// GetResourceRequestStatus mocks base method.
+func (m *MockCloudControlAPI) GetResourceRequestStatus(ctx context.Context, params *cloudcontrol.GetResourceRequestStatusInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.GetResourceRequestStatusOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetResourceRequestStatus", varargs...)
+	ret0, _ := ret[0].(*cloudcontrol.GetResourceRequestStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceRequestStatus indicates an expected call of GetResourceRequestStatus.
+func (mr *MockCloudControlAPIMockRecorder) GetResourceRequestStatus(ctx, params interface{}, optFns ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, params}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceRequestStatus", reflect.TypeOf((*MockCloudControlAPI)(nil).GetResourceRequestStatus), varargs...)
+}
+
+// ListResources mocks base method.
+func (m *MockCloudControlAPI) ListResources(ctx context.Context, params *cloudcontrol.ListResourcesInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.ListResourcesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResources", varargs...)
+	ret0, _ := ret[0].(*cloudcontrol.ListResourcesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResources indicates an expected call of ListResources.
+func (mr *MockCloudControlAPIMockRecorder) ListResources(ctx, params interface{}, optFns ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, params}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResources", reflect.TypeOf((*MockCloudControlAPI)(nil).ListResources), varargs...)
+}
+
+// UpdateResource mocks base method.
+func (m *MockCloudControlAPI) UpdateResource(ctx context.Context, params *cloudcontrol.UpdateResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.UpdateResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateResource", varargs...)
+	ret0, _ := ret[0].(*cloudcontrol.UpdateResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateResource indicates an expected call of UpdateResource.
+func (mr *MockCloudControlAPIMockRecorder) UpdateResource(ctx, params interface{}, optFns ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, params}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResource", reflect.TypeOf((*MockCloudControlAPI)(nil).UpdateResource), varargs...)
+}
+
+// MockCloudFormationAPI is a mock of CloudFormationAPI interface.
+type MockCloudFormationAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCloudFormationAPIMockRecorder
+}
+
+// MockCloudFormationAPIMockRecorder is the mock recorder for MockCloudFormationAPI.
+type MockCloudFormationAPIMockRecorder struct {
+	mock *MockCloudFormationAPI
+}
+
+// NewMockCloudFormationAPI creates a new mock instance.
+func NewMockCloudFormationAPI(ctrl *gomock.Controller) *MockCloudFormationAPI {
+	mock := &MockCloudFormationAPI{ctrl: ctrl}
+	mock.recorder = &MockCloudFormationAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCloudFormationAPI) EXPECT() *MockCloudFormationAPIMockRecorder {
+	return m.recorder
+}
+
+// DescribeType mocks base method.
+func (m *MockCloudFormationAPI) DescribeType(ctx context.Context, params *cloudformation.DescribeTypeInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeTypeOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeType", varargs...)
+	ret0, _ := ret[0].(*cloudformation.DescribeTypeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeType indicates an expected call of DescribeType.
+func (mr *MockCloudFormationAPIMockRecorder) DescribeType(ctx, params interface{}, optFns ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, params}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeType", reflect.TypeOf((*MockCloudFormationAPI)(nil).DescribeType), varargs...)
+}
+
+// ListTypes mocks base method.
+func (m *MockCloudFormationAPI) ListTypes(ctx context.Context, params *cloudformation.ListTypesInput, optFns ...func(*cloudformation.Options)) (*cloudformation.ListTypesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTypes", varargs...)
+	ret0, _ := ret[0].(*cloudformation.ListTypesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTypes indicates an expected call of ListTypes.
+func (mr *MockCloudFormationAPIMockRecorder) ListTypes(ctx, params interface{}, optFns ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, params}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTypes", reflect.TypeOf((*MockCloudFormationAPI)(nil).ListTypes), varargs...)
+}
+
+// MockEC2API is a mock of EC2API interface.
+type MockEC2API struct {
+	ctrl     *gomock.Controller
+	recorder *MockEC2APIMockRecorder
+}
+
+// MockEC2APIMockRecorder is the mock recorder for MockEC2API.
+type MockEC2APIMockRecorder struct {
+	mock *MockEC2API
+}
+
+// NewMockEC2API creates a new mock instance.
+func NewMockEC2API(ctrl *gomock.Controller) *MockEC2API {
+	mock := &MockEC2API{ctrl: ctrl}
+	mock.recorder = &MockEC2APIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEC2API) EXPECT() *MockEC2APIMockRecorder {
+	return m.recorder
+}
+
+// DescribeRegions mocks base method.
+func (m *MockEC2API) DescribeRegions(ctx context.Context, params *ec2.DescribeRegionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeRegionsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeRegions", varargs...)
+	ret0, _ := ret[0].(*ec2.DescribeRegionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRegions indicates an expected call of DescribeRegions.
+func (mr *MockEC2APIMockRecorder) DescribeRegions(ctx, params interface{}, optFns ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, params}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRegions", reflect.TypeOf((*MockEC2API)(nil).DescribeRegions), varargs...)
+}
+
+// MockSTSAPI is a mock of STSAPI interface.
+type MockSTSAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSTSAPIMockRecorder
+}
+
+// MockSTSAPIMockRecorder is the mock recorder for MockSTSAPI.
+type MockSTSAPIMockRecorder struct {
+	mock *MockSTSAPI
+}
+
+// NewMockSTSAPI creates a new mock instance.
+func NewMockSTSAPI(ctrl *gomock.Controller) *MockSTSAPI {
+	mock := &MockSTSAPI{ctrl: ctrl}
+	mock.recorder = &MockSTSAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSTSAPI) EXPECT() *MockSTSAPIMockRecorder {
+	return m.recorder
+}
+
+// AssumeRole mocks base method.
+func (m *MockSTSAPI) AssumeRole(ctx context.Context, params *sts.AssumeRoleInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AssumeRole", varargs...)
+	ret0, _ := ret[0].(*sts.AssumeRoleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssumeRole indicates an expected call of AssumeRole.
+func (mr *MockSTSAPIMockRecorder) AssumeRole(ctx, params interface{}, optFns ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, params}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssumeRole", reflect.TypeOf((*MockSTSAPI)(nil).AssumeRole), varargs...)
+}
+
+// GetCallerIdentity mocks base method.
+func (m *MockSTSAPI) GetCallerIdentity(ctx context.Context, params *sts.GetCallerIdentityInput, optFns ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCallerIdentity", varargs...)
+	ret0, _ := ret[0].(*sts.GetCallerIdentityOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCallerIdentity indicates an expected call of GetCallerIdentity.
+func (mr *MockSTSAPIMockRecorder) GetCallerIdentity(ctx, params interface{}, optFns ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, params}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCallerIdentity", reflect.TypeOf((*MockSTSAPI)(nil).GetCallerIdentity), varargs...)
+}
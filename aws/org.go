@@ -0,0 +1,340 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"sync"
+
+	v2aws "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/organizations/organizationsiface"
+	"github.com/gruntwork-io/cloud-nuke/externalcreds"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/go-commons/collections"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/pterm/pterm"
+)
+
+// DefaultOrgRoleName is the role that AWS Organizations creates in the accounts it creates, which lets the
+// management account administer them
+const DefaultOrgRoleName = "OrganizationAccountAccessRole"
+
+// OrgOptions selects the member accounts of an organization to run against, and how to access them
+type OrgOptions struct {
+	// OrganizationalUnits, when set, only selects the accounts in these OUs or in the OUs nested in them
+	OrganizationalUnits []string
+	// Tags, when set, only selects the accounts that have all of these tags
+	Tags map[string]string
+	// AccountIDs, when set, only selects these accounts
+	AccountIDs []string
+	// RoleName is the role assumed in each account
+	RoleName string
+	// Parallelism is the number of accounts run against at the same time
+	Parallelism int
+}
+
+// OrgAccount is a member account of an organization, or any other account to run against with RunInOrgAccounts
+type OrgAccount struct {
+	ID   string
+	Name string
+	// Credentials, when set, are used in the account instead of assuming the role of OrgOptions, such as the
+	// credentials of a profile
+	Credentials v2aws.CredentialsProvider
+}
+
+// OrgReport is the combined outcome of a run against the member accounts of an organization, keyed by account ID
+type OrgReport struct {
+	Accounts map[string]*OrgAccountResult `json:"accounts"`
+
+	mutex sync.Mutex
+}
+
+// OrgAccountResult is the outcome of a run against a single member account
+type OrgAccountResult struct {
+	AccountID   string `json:"account_id"`
+	AccountName string `json:"account_name,omitempty"`
+	// RoleArn is the role assumed in the account, in the partition of the organization
+	RoleArn   string `json:"role_arn,omitempty"`
+	Resources int    `json:"resources"`
+	Remaining int    `json:"remaining,omitempty"`
+	RunFile   string `json:"run_file,omitempty"`
+	PlanFile  string `json:"plan_file,omitempty"`
+	Skipped   string `json:"skipped,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// NewOrgReport returns an empty report
+func NewOrgReport() *OrgReport {
+	return &OrgReport{Accounts: map[string]*OrgAccountResult{}}
+}
+
+// Result returns the result of the account, adding it to the report if needed
+func (report *OrgReport) Result(account OrgAccount) *OrgAccountResult {
+	report.mutex.Lock()
+	defer report.mutex.Unlock()
+
+	result, ok := report.Accounts[account.ID]
+	if !ok {
+		result = &OrgAccountResult{AccountID: account.ID, AccountName: account.Name}
+		report.Accounts[account.ID] = result
+	}
+	return result
+}
+
+// FailedCount returns the number of accounts whose run returned an error
+func (report *OrgReport) FailedCount() int {
+	report.mutex.Lock()
+	defer report.mutex.Unlock()
+
+	failed := 0
+	for _, result := range report.Accounts {
+		if result.Error != "" {
+			failed++
+		}
+	}
+	return failed
+}
+
+// ListOrgAccounts lists the active member accounts of the organization of the current credentials that opts selects.
+// The management account is never selected.
+func ListOrgAccounts(ctx context.Context, opts OrgOptions) ([]OrgAccount, error) {
+	config, err := newConfig(ctx, defaultRegion(ctx))
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	services, err := clientsFrom(ctx).Services(config)
+	if err != nil {
+		return nil, err
+	}
+	svc := services.Organizations
+
+	organization, err := svc.DescribeOrganizationWithContext(ctx, &organizations.DescribeOrganizationInput{})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	managementAccountID := awsgo.StringValue(organization.Organization.MasterAccountId)
+
+	members := []*organizations.Account{}
+	if len(opts.OrganizationalUnits) == 0 {
+		err = svc.ListAccountsPagesWithContext(ctx, &organizations.ListAccountsInput{}, func(page *organizations.ListAccountsOutput, lastPage bool) bool {
+			members = append(members, page.Accounts...)
+			return true
+		})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+	}
+	for _, organizationalUnit := range opts.OrganizationalUnits {
+		accountsInUnit, err := listAccountsInUnit(ctx, svc, organizationalUnit)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, accountsInUnit...)
+	}
+
+	accounts := []OrgAccount{}
+	seen := map[string]bool{}
+	for _, member := range members {
+		account := OrgAccount{ID: awsgo.StringValue(member.Id), Name: awsgo.StringValue(member.Name)}
+		if seen[account.ID] || account.ID == managementAccountID || awsgo.StringValue(member.Status) != organizations.AccountStatusActive {
+			continue
+		}
+		seen[account.ID] = true
+
+		if len(opts.AccountIDs) > 0 && !collections.ListContainsElement(opts.AccountIDs, account.ID) {
+			continue
+		}
+
+		if len(opts.Tags) > 0 {
+			tags, err := listAccountTags(ctx, svc, account.ID)
+			if err != nil {
+				return nil, err
+			}
+			if !hasTags(tags, opts.Tags) {
+				continue
+			}
+		}
+
+		accounts = append(accounts, account)
+	}
+
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].ID < accounts[j].ID })
+	return accounts, nil
+}
+
+// listAccountsInUnit lists the accounts in an OU and in the OUs nested in it
+func listAccountsInUnit(ctx context.Context, svc organizationsiface.OrganizationsAPI, organizationalUnit string) ([]*organizations.Account, error) {
+	accounts := []*organizations.Account{}
+	err := svc.ListAccountsForParentPagesWithContext(ctx, &organizations.ListAccountsForParentInput{ParentId: awsgo.String(organizationalUnit)}, func(page *organizations.ListAccountsForParentOutput, lastPage bool) bool {
+		accounts = append(accounts, page.Accounts...)
+		return true
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	children := []string{}
+	err = svc.ListOrganizationalUnitsForParentPagesWithContext(ctx, &organizations.ListOrganizationalUnitsForParentInput{ParentId: awsgo.String(organizationalUnit)}, func(page *organizations.ListOrganizationalUnitsForParentOutput, lastPage bool) bool {
+		for _, child := range page.OrganizationalUnits {
+			children = append(children, awsgo.StringValue(child.Id))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	for _, child := range children {
+		accountsInChild, err := listAccountsInUnit(ctx, svc, child)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, accountsInChild...)
+	}
+	return accounts, nil
+}
+
+func listAccountTags(ctx context.Context, svc organizationsiface.OrganizationsAPI, accountID string) (map[string]string, error) {
+	tags := map[string]string{}
+	err := svc.ListTagsForResourcePagesWithContext(ctx, &organizations.ListTagsForResourceInput{ResourceId: awsgo.String(accountID)}, func(page *organizations.ListTagsForResourceOutput, lastPage bool) bool {
+		for _, tag := range page.Tags {
+			tags[awsgo.StringValue(tag.Key)] = awsgo.StringValue(tag.Value)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return tags, nil
+}
+
+// hasTags returns true when tags has every key of required, with the same value
+func hasTags(tags, required map[string]string) bool {
+	for key, value := range required {
+		if actual, ok := tags[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+// orgRoleArn returns the ARN of the role to assume in a member account of an organization of partition
+func orgRoleArn(partition Partition, accountID, roleName string) string {
+	return partition.Arn("iam", "", accountID, "role/"+roleName)
+}
+
+// WithOrgAccountCredentials returns a copy of ctx whose clients use the credentials of the account, or else assume
+// the role of opts in the account
+func WithOrgAccountCredentials(ctx context.Context, account OrgAccount, opts OrgOptions) (context.Context, error) {
+	if account.Credentials != nil {
+		return externalcreds.WithCredentials(ctx, account.Credentials), nil
+	}
+
+	config, err := newConfig(ctx, defaultRegion(ctx))
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	provider := stscreds.NewAssumeRoleProvider(clientsFrom(ctx).STS(config), orgRoleArn(partitionOf(ctx), account.ID, opts.RoleName), func(options *stscreds.AssumeRoleOptions) {
+		options.RoleSessionName = externalcreds.DefaultRoleSessionName
+	})
+	return externalcreds.WithCredentials(ctx, v2aws.NewCredentialsCache(provider)), nil
+}
+
+// RunInOrgAccounts calls run for every account, at most opts.Parallelism at a time, with a context whose clients
+// use the credentials of the account, as WithOrgAccountCredentials does. The error returned by run is recorded in the
+// account's result, so that one failing account doesn't stop the others.
+func RunInOrgAccounts(ctx context.Context, report *OrgReport, accounts []OrgAccount, opts OrgOptions, run func(ctx context.Context, result *OrgAccountResult) error) {
+	parallelism := opts.Parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	semaphore := make(chan struct{}, parallelism)
+	wg := new(sync.WaitGroup)
+	for _, account := range accounts {
+		wg.Add(1)
+		go func(account OrgAccount) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			result := report.Result(account)
+			if account.Credentials == nil {
+				result.RoleArn = orgRoleArn(partitionOf(ctx), account.ID, opts.RoleName)
+			}
+			if ctx.Err() != nil {
+				result.Error = ctx.Err().Error()
+				return
+			}
+
+			accountCtx, err := WithOrgAccountCredentials(ctx, account, opts)
+			if err == nil {
+				err = run(accountCtx, result)
+			}
+			if err != nil {
+				logging.Logger.Errorf("[%s] %s", account.ID, err)
+				result.Error = err.Error()
+			}
+		}(account)
+	}
+	wg.Wait()
+}
+
+// WriteOrgReport writes the report as JSON
+func WriteOrgReport(path string, report *OrgReport) error {
+	report.mutex.Lock()
+	defer report.mutex.Unlock()
+
+	contents, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return errors.WithStackTrace(ioutil.WriteFile(path, contents, 0644))
+}
+
+// RenderOrgReport prints the outcome of every account of the report
+func RenderOrgReport(report *OrgReport) {
+	report.mutex.Lock()
+	defer report.mutex.Unlock()
+
+	accountIDs := []string{}
+	for accountID := range report.Accounts {
+		accountIDs = append(accountIDs, accountID)
+	}
+	sort.Strings(accountIDs)
+
+	tableData := pterm.TableData{{"Account", "Name", "Resources", "Remaining", "File", "Error"}}
+	for _, accountID := range accountIDs {
+		result := report.Accounts[accountID]
+		file := result.RunFile
+		if result.PlanFile != "" {
+			file = result.PlanFile
+		}
+		message := result.Error
+		if result.Skipped != "" {
+			message = "skipped: " + result.Skipped
+		}
+		tableData = append(tableData, []string{
+			result.AccountID,
+			result.AccountName,
+			fmt.Sprint(result.Resources),
+			fmt.Sprint(result.Remaining),
+			file,
+			truncateText(message, 60),
+		})
+	}
+
+	pterm.Println()
+	renderSection("Organization accounts")
+	pterm.DefaultTable.
+		WithHasHeader().
+		WithData(tableData).
+		Render()
+	pterm.Println()
+}
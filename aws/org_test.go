@@ -0,0 +1,70 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasTags(t *testing.T) {
+	t.Parallel()
+
+	tags := map[string]string{"environment": "sandbox", "team": "platform"}
+	assert.True(t, hasTags(tags, nil))
+	assert.True(t, hasTags(tags, map[string]string{"environment": "sandbox"}))
+	assert.False(t, hasTags(tags, map[string]string{"environment": "production"}))
+	assert.False(t, hasTags(tags, map[string]string{"owner": ""}))
+}
+
+func TestOrgRoleArn(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "arn:aws:iam::111111111111:role/OrganizationAccountAccessRole", orgRoleArn(mustPartition("aws"), "111111111111", DefaultOrgRoleName))
+	assert.Equal(t, "arn:aws-cn:iam::111111111111:role/nuke", orgRoleArn(mustPartition("aws-cn"), "111111111111", "nuke"))
+}
+
+func TestRunInOrgAccounts(t *testing.T) {
+	t.Parallel()
+
+	accounts := []OrgAccount{}
+	for i := 1; i <= 6; i++ {
+		accounts = append(accounts, OrgAccount{ID: fmt.Sprintf("%012d", i), Name: fmt.Sprintf("sandbox-%d", i)})
+	}
+
+	var mutex sync.Mutex
+	running, maxRunning := 0, 0
+	report := NewOrgReport()
+	ctx := WithPartition(context.Background(), mustPartition("aws-us-gov"))
+	RunInOrgAccounts(ctx, report, accounts, OrgOptions{RoleName: DefaultOrgRoleName, Parallelism: 2}, func(ctx context.Context, result *OrgAccountResult) error {
+		mutex.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mutex.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+		result.Resources = 1
+
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+
+		if result.AccountID == accounts[2].ID {
+			return errors.New("AccessDenied")
+		}
+		return nil
+	})
+
+	assert.LessOrEqual(t, maxRunning, 2)
+	assert.Len(t, report.Accounts, 6)
+	assert.Equal(t, 1, report.FailedCount())
+	assert.Equal(t, "AccessDenied", report.Accounts[accounts[2].ID].Error)
+	assert.Equal(t, "sandbox-1", report.Accounts[accounts[0].ID].AccountName)
+	assert.Equal(t, 1, report.Accounts[accounts[5].ID].Resources)
+	assert.Equal(t, "arn:aws-us-gov:iam::000000000006:role/OrganizationAccountAccessRole", report.Accounts[accounts[5].ID].RoleArn)
+}
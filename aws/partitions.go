@@ -0,0 +1,241 @@
+package aws
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// Region opt-in statuses, as returned by EC2 DescribeRegions. In the partition catalog, regions that new accounts must
+// enable before using them are RegionNotOptedIn.
+const (
+	RegionOptInNotRequired = "opt-in-not-required"
+	RegionOptedIn          = "opted-in"
+	RegionNotOptedIn       = "not-opted-in"
+)
+
+// partitionsJSON is the partition catalog: the partitions of AWS, with their regions and opt-in statuses
+//
+//go:embed partitions.json
+var partitionsJSON []byte
+
+// Partition is a group of regions with its own accounts, credentials and endpoints, such as the commercial regions,
+// the China regions or GovCloud
+type Partition struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	DNSSuffix string `json:"dnsSuffix"`
+	// DefaultRegion is the region of the global services of the partition, which cloud-nuke also calls before it knows
+	// the regions of the account
+	DefaultRegion string `json:"defaultRegion"`
+	// RegionRegex matches the names of the regions of the partition, including those newer than the catalog
+	RegionRegex string            `json:"regionRegex"`
+	Regions     []PartitionRegion `json:"regions"`
+
+	regionRegex *regexp.Regexp
+}
+
+// PartitionRegion is a region of a partition
+type PartitionRegion struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// OptInStatus is RegionNotOptedIn for regions that must be enabled before they are used, and
+	// RegionOptInNotRequired for the others
+	OptInStatus string `json:"optInStatus"`
+}
+
+// Enabled returns true if the region can be used without enabling it first
+func (region PartitionRegion) Enabled() bool {
+	return region.OptInStatus != RegionNotOptedIn
+}
+
+// partitionCatalog is the catalog of partitionsJSON
+var partitionCatalog = mustLoadPartitions(partitionsJSON)
+
+// mustLoadPartitions parses a partition catalog. The catalog is embedded, so it panics if the catalog is invalid.
+func mustLoadPartitions(data []byte) []Partition {
+	catalog := struct {
+		Partitions []Partition `json:"partitions"`
+	}{}
+	if err := json.Unmarshal(data, &catalog); err != nil {
+		panic(err)
+	}
+	for i := range catalog.Partitions {
+		catalog.Partitions[i].regionRegex = regexp.MustCompile(catalog.Partitions[i].RegionRegex)
+	}
+	return catalog.Partitions
+}
+
+// Partitions returns the partitions of the catalog, starting with the commercial one
+func Partitions() []Partition {
+	return append([]Partition{}, partitionCatalog...)
+}
+
+// PartitionByID returns the partition of the catalog with the given ID, such as aws-cn
+func PartitionByID(id string) (Partition, error) {
+	for _, partition := range partitionCatalog {
+		if partition.ID == id {
+			return partition, nil
+		}
+	}
+	return Partition{}, errors.WithStackTrace(UnknownPartitionError{Value: id})
+}
+
+// PartitionOfRegion returns the partition that a region belongs to. Regions that are newer than the catalog are
+// matched by name.
+func PartitionOfRegion(region string) (Partition, error) {
+	for _, partition := range partitionCatalog {
+		for _, partitionRegion := range partition.Regions {
+			if partitionRegion.Name == region {
+				return partition, nil
+			}
+		}
+	}
+	for _, partition := range partitionCatalog {
+		if partition.regionRegex.MatchString(region) {
+			return partition, nil
+		}
+	}
+	return Partition{}, errors.WithStackTrace(UnknownPartitionError{Value: region})
+}
+
+// PartitionOfArn returns the partition of an ARN, such as the ARN of the caller of STS GetCallerIdentity
+func PartitionOfArn(arn string) (Partition, error) {
+	parts := strings.SplitN(arn, ":", 3)
+	if len(parts) < 3 || parts[0] != "arn" {
+		return Partition{}, errors.WithStackTrace(UnknownPartitionError{Value: arn})
+	}
+	return PartitionByID(parts[1])
+}
+
+// RegionNames returns the names of the regions of the partition in the catalog
+func (partition Partition) RegionNames() []string {
+	names := []string{}
+	for _, region := range partition.Regions {
+		names = append(names, region.Name)
+	}
+	return names
+}
+
+// EnabledRegionNames returns the names of the regions of the partition that are enabled in new accounts
+func (partition Partition) EnabledRegionNames() []string {
+	names := []string{}
+	for _, region := range partition.Regions {
+		if region.Enabled() {
+			names = append(names, region.Name)
+		}
+	}
+	return names
+}
+
+// Arn returns the ARN of a resource of the partition. Global resources, such as IAM roles, have no region.
+func (partition Partition) Arn(service, region, accountID, resource string) string {
+	return fmt.Sprintf("arn:%s:%s:%s:%s:%s", partition.ID, service, region, accountID, resource)
+}
+
+// region returns the region of the partition in the catalog, if it's there
+func (partition Partition) region(name string) (PartitionRegion, bool) {
+	for _, region := range partition.Regions {
+		if region.Name == name {
+			return region, true
+		}
+	}
+	return PartitionRegion{}, false
+}
+
+// partitionKey is the context key of the partition set by WithPartition
+type partitionKey struct{}
+
+// offlineRegionsKey is the context key set by WithOfflineRegions
+type offlineRegionsKey struct{}
+
+// WithPartition returns a copy of ctx whose calls to AWS are made in partition, instead of the partition detected
+// from the regions that accept the credentials
+func WithPartition(ctx context.Context, partition Partition) context.Context {
+	return context.WithValue(ctx, partitionKey{}, partition)
+}
+
+// WithOfflineRegions returns a copy of ctx whose regions, and partition, come from the partition catalog instead of
+// EC2 and STS: the enabled regions are the regions of the partition that are enabled in new accounts. Tests use it to
+// run without a network.
+func WithOfflineRegions(ctx context.Context) context.Context {
+	return context.WithValue(ctx, offlineRegionsKey{}, true)
+}
+
+// partitionFrom returns the partition set on ctx with WithPartition, if any
+func partitionFrom(ctx context.Context) (Partition, bool) {
+	partition, ok := ctx.Value(partitionKey{}).(Partition)
+	return partition, ok
+}
+
+// partitionOf returns the partition of ctx: the one set with WithPartition, or else the most likely one
+func partitionOf(ctx context.Context) Partition {
+	return candidatePartitions(ctx)[0]
+}
+
+// defaultRegion returns the default region of the partition of ctx, where global services are called
+func defaultRegion(ctx context.Context) string {
+	return partitionOf(ctx).DefaultRegion
+}
+
+func offlineRegions(ctx context.Context) bool {
+	offline, _ := ctx.Value(offlineRegionsKey{}).(bool)
+	return offline
+}
+
+// candidatePartitions returns the partitions that the credentials of ctx may belong to, most likely first: the
+// partition set on ctx, or else every partition of the catalog, starting with the partition of the region configured
+// in the environment
+func candidatePartitions(ctx context.Context) []Partition {
+	if partition, ok := partitionFrom(ctx); ok {
+		return []Partition{partition}
+	}
+	return orderPartitions(partitionCatalog, environmentRegion())
+}
+
+// orderPartitions moves the partition of region, if any, to the front of partitions
+func orderPartitions(partitions []Partition, region string) []Partition {
+	ordered := []Partition{}
+	if hinted, err := PartitionOfRegion(region); err == nil {
+		ordered = append(ordered, hinted)
+	}
+	for _, partition := range partitions {
+		if len(ordered) == 0 || partition.ID != ordered[0].ID {
+			ordered = append(ordered, partition)
+		}
+	}
+	return ordered
+}
+
+// environmentRegion returns the region configured with the environment variables of the AWS CLI and SDKs, if any
+func environmentRegion() string {
+	if region := os.Getenv("AWS_REGION"); region != "" {
+		return region
+	}
+	return os.Getenv("AWS_DEFAULT_REGION")
+}
+
+// DetectPartition returns the partition that cloud-nuke runs in: the one set on ctx with WithPartition, or else the
+// partition of the caller of STS GetCallerIdentity. With WithOfflineRegions, nothing is called and the most likely
+// partition is returned.
+func DetectPartition(ctx context.Context) (Partition, error) {
+	if partition, ok := partitionFrom(ctx); ok {
+		return partition, nil
+	}
+	if offlineRegions(ctx) {
+		return partitionOf(ctx), nil
+	}
+
+	output, _, err := getCallerIdentity(ctx)
+	if err != nil {
+		return Partition{}, err
+	}
+	return PartitionOfArn(awsgo.StringValue(output.Arn))
+}
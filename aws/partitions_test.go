@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2_types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	mock_aws "github.com/gruntwork-io/cloud-nuke/aws/mocks/clients"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func partitionIDs(partitions []Partition) []string {
+	ids := []string{}
+	for _, partition := range partitions {
+		ids = append(ids, partition.ID)
+	}
+	return ids
+}
+
+func TestPartitionCatalog(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"aws", "aws-cn", "aws-us-gov", "aws-iso", "aws-iso-b"}, partitionIDs(Partitions()))
+
+	for _, partition := range Partitions() {
+		assert.NotEmpty(t, partition.Regions, partition.ID)
+		assert.Contains(t, partition.EnabledRegionNames(), partition.DefaultRegion, partition.ID)
+		for _, region := range partition.Regions {
+			assert.Regexp(t, partition.RegionRegex, region.Name)
+			assert.Contains(t, []string{RegionOptInNotRequired, RegionNotOptedIn}, region.OptInStatus, region.Name)
+
+			regionPartition, err := PartitionOfRegion(region.Name)
+			require.NoError(t, err)
+			assert.Equal(t, partition.ID, regionPartition.ID)
+		}
+	}
+
+	assert.Contains(t, OptInNotRequiredRegions, "us-east-1")
+	assert.NotContains(t, OptInNotRequiredRegions, "af-south-1")
+	assert.ElementsMatch(t, []string{"us-gov-east-1", "us-gov-west-1"}, GovCloudRegions)
+}
+
+func TestPartitionOfRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		region    string
+		partition string
+	}{
+		{"eu-west-1", "aws"},
+		{"eu-east-9", "aws"},
+		{"cn-northwest-1", "aws-cn"},
+		{"us-gov-east-1", "aws-us-gov"},
+		{"us-gov-north-2", "aws-us-gov"},
+		{"us-iso-west-1", "aws-iso"},
+		{"us-isob-east-1", "aws-iso-b"},
+	}
+	for _, testCase := range testCases {
+		partition, err := PartitionOfRegion(testCase.region)
+		require.NoError(t, err, testCase.region)
+		assert.Equal(t, testCase.partition, partition.ID, testCase.region)
+	}
+
+	_, err := PartitionOfRegion("global")
+	assert.Equal(t, UnknownPartitionError{Value: "global"}, errors.Unwrap(err))
+}
+
+func TestPartitionOfArn(t *testing.T) {
+	t.Parallel()
+
+	partition, err := PartitionOfArn("arn:aws-cn:sts::123456789012:assumed-role/admin/me")
+	require.NoError(t, err)
+	assert.Equal(t, "cn-north-1", partition.DefaultRegion)
+
+	identity := &AccountIdentity{AccountID: "123456789012", CallerArn: "arn:aws-us-gov:iam::123456789012:user/me"}
+	partition, err = identity.Partition()
+	require.NoError(t, err)
+	assert.Equal(t, "aws-us-gov", partition.ID)
+
+	for _, arn := range []string{"", "sts::123456789012", "arn:aws-mars:iam::123456789012:user/me"} {
+		_, err := PartitionOfArn(arn)
+		assert.Error(t, err, arn)
+	}
+}
+
+func TestOrderPartitions(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"aws", "aws-cn", "aws-us-gov", "aws-iso", "aws-iso-b"}, partitionIDs(orderPartitions(Partitions(), "")))
+	assert.Equal(t, []string{"aws-cn", "aws", "aws-us-gov", "aws-iso", "aws-iso-b"}, partitionIDs(orderPartitions(Partitions(), "cn-north-1")))
+	assert.Equal(t, []string{"aws", "aws-cn", "aws-us-gov", "aws-iso", "aws-iso-b"}, partitionIDs(orderPartitions(Partitions(), "eu-west-1")))
+}
+
+func TestDetectPartitionWithoutCallingAWS(t *testing.T) {
+	t.Parallel()
+
+	govCloud, err := PartitionByID("aws-us-gov")
+	require.NoError(t, err)
+
+	partition, err := DetectPartition(WithPartition(context.Background(), govCloud))
+	require.NoError(t, err)
+	assert.Equal(t, "aws-us-gov", partition.ID)
+
+	partition, err = DetectPartition(WithOfflineRegions(WithPartition(context.Background(), govCloud)))
+	require.NoError(t, err)
+	assert.Equal(t, "aws-us-gov", partition.ID)
+}
+
+func TestGetEnabledRegionsOffline(t *testing.T) {
+	t.Parallel()
+
+	ctx := WithOfflineRegions(context.Background())
+	regions, err := GetEnabledRegionsWithContext(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, mustPartition("aws").EnabledRegionNames(), regions)
+
+	china, err := PartitionByID("aws-cn")
+	require.NoError(t, err)
+	regions, err = GetEnabledRegionsWithContext(WithPartition(ctx, china))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cn-north-1", "cn-northwest-1"}, regions)
+}
+
+func TestGetAccountRegionsInPartition(t *testing.T) {
+	t.Parallel()
+
+	china, err := PartitionByID("aws-cn")
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	mockEC2 := mock_aws.NewMockEC2API(ctrl)
+	mockEC2.EXPECT().
+		DescribeRegions(gomock.Any(), &ec2.DescribeRegionsInput{AllRegions: aws.Bool(true)}).
+		Return(nil, assert.AnError).
+		Times(1)
+
+	ctx := WithPartition(WithClientFactory(context.Background(), StaticClientFactory{EC2Client: mockEC2}), china)
+	_, err = GetAccountRegions(ctx)
+	assert.IsType(t, CouldNotDetermineEnabledRegionsError{}, errors.Unwrap(err))
+
+	mockEC2.EXPECT().
+		DescribeRegions(gomock.Any(), gomock.Any()).
+		Return(&ec2.DescribeRegionsOutput{Regions: []ec2_types.Region{
+			{RegionName: aws.String("cn-north-1"), OptInStatus: aws.String(RegionOptInNotRequired)},
+		}}, nil)
+	regions, err := GetAccountRegions(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []PartitionRegion{{Name: "cn-north-1", Description: "China (Beijing)", OptInStatus: RegionOptInNotRequired}}, regions)
+}
+
+func TestGlobalResourcesAreCalledInTheDefaultRegionOfThePartition(t *testing.T) {
+	t.Parallel()
+
+	ctx := WithPartition(context.Background(), mustPartition("aws-us-gov"))
+	assert.Equal(t, "us-gov-west-1", sessionRegion(ctx, GlobalRegion))
+	assert.Equal(t, "us-gov-east-1", sessionRegion(ctx, "us-gov-east-1"))
+
+	ctx = WithPartition(context.Background(), mustPartition("aws-cn"))
+	assert.Equal(t, "cn-north-1", sessionRegion(ctx, GlobalRegion))
+	assert.Equal(t, "arn:aws-cn:iam::123456789012:role/nuke", partitionOf(ctx).Arn("iam", "", "123456789012", "role/nuke"))
+}
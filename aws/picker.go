@@ -0,0 +1,338 @@
+package aws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/pterm/pterm"
+)
+
+const (
+	pickerBrowse     = "Browse resources by region and type"
+	pickerSearch     = "Search resources"
+	pickerToggleType = "Toggle whole resource types"
+	pickerProperties = "View the properties of a resource"
+	pickerCancel     = "Cancel without nuking anything"
+)
+
+// pickerMaxHeight is the number of options the interactive menus show at once
+const pickerMaxHeight = 15
+
+// selectableResource is a resource in the interactive picker
+type selectableResource struct {
+	Region     string
+	TypeName   string
+	Identifier string
+	Selected   bool
+}
+
+// label identifies the resource in menus that mix regions and types
+func (resource *selectableResource) label() string {
+	return fmt.Sprintf("%s | %s | %s", resource.Region, resource.TypeName, resource.Identifier)
+}
+
+// resourceSelection is the state of the interactive picker: every scanned resource, and whether it is still selected
+// for nuking
+type resourceSelection struct {
+	resources []*selectableResource
+}
+
+func newResourceSelection(account *AwsAccountResources) *resourceSelection {
+	selection := &resourceSelection{}
+	for region, resourcesInRegion := range account.Resources {
+		for _, resources := range resourcesInRegion.Resources {
+			for _, identifier := range resources.ResourceIdentifiers() {
+				selection.resources = append(selection.resources, &selectableResource{
+					Region:     region,
+					TypeName:   resources.ResourceName(),
+					Identifier: identifier,
+					Selected:   true,
+				})
+			}
+		}
+	}
+	sort.Slice(selection.resources, func(i, j int) bool {
+		return selection.resources[i].label() < selection.resources[j].label()
+	})
+	return selection
+}
+
+// regions returns the regions of the resources, sorted
+func (selection *resourceSelection) regions() []string {
+	regions := []string{}
+	for _, resource := range selection.resources {
+		if len(regions) == 0 || regions[len(regions)-1] != resource.Region {
+			regions = append(regions, resource.Region)
+		}
+	}
+	return regions
+}
+
+// typeNames returns the types of the resources in region, or in every region if region is empty, sorted
+func (selection *resourceSelection) typeNames(region string) []string {
+	seen := map[string]bool{}
+	typeNames := []string{}
+	for _, resource := range selection.filter(region, "") {
+		if !seen[resource.TypeName] {
+			seen[resource.TypeName] = true
+			typeNames = append(typeNames, resource.TypeName)
+		}
+	}
+	sort.Strings(typeNames)
+	return typeNames
+}
+
+// filter returns the resources in region and of typeName. An empty region or typeName matches any.
+func (selection *resourceSelection) filter(region, typeName string) []*selectableResource {
+	matches := []*selectableResource{}
+	for _, resource := range selection.resources {
+		if (region == "" || resource.Region == region) && (typeName == "" || resource.TypeName == typeName) {
+			matches = append(matches, resource)
+		}
+	}
+	return matches
+}
+
+// search returns the resources whose region, type or identifier contains query, ignoring case
+func (selection *resourceSelection) search(query string) []*selectableResource {
+	query = strings.ToLower(strings.TrimSpace(query))
+	matches := []*selectableResource{}
+	for _, resource := range selection.resources {
+		if strings.Contains(strings.ToLower(resource.label()), query) {
+			matches = append(matches, resource)
+		}
+	}
+	return matches
+}
+
+// setTypes selects every resource of the given types, and deselects every resource of the other types. Types that
+// were already partly selected keep their selection.
+func (selection *resourceSelection) setTypes(typeNames []string) {
+	for _, typeName := range selection.typeNames("") {
+		resources := selection.filter("", typeName)
+		keep := false
+		for _, selected := range typeNames {
+			keep = keep || selected == typeName
+		}
+
+		if !keep {
+			setSelected(resources, false)
+		} else if countSelected(resources) == 0 {
+			setSelected(resources, true)
+		}
+	}
+}
+
+// selected returns the resources that are still selected
+func (selection *resourceSelection) selected() *AwsAccountResources {
+	account := &AwsAccountResources{
+		Resources: make(map[string]AwsRegionResource),
+	}
+	for _, resource := range selection.resources {
+		if resource.Selected {
+			account.add(resource.Region, resource.TypeName, resource.Identifier)
+		}
+	}
+	return account
+}
+
+func setSelected(resources []*selectableResource, selected bool) {
+	for _, resource := range resources {
+		resource.Selected = selected
+	}
+}
+
+func countSelected(resources []*selectableResource) int {
+	count := 0
+	for _, resource := range resources {
+		if resource.Selected {
+			count++
+		}
+	}
+	return count
+}
+
+// applyChoices selects the resources whose label is in choices, and deselects the others
+func applyChoices(resources []*selectableResource, label func(*selectableResource) string, choices []string) {
+	chosen := map[string]bool{}
+	for _, choice := range choices {
+		chosen[choice] = true
+	}
+	for _, resource := range resources {
+		resource.Selected = chosen[label(resource)]
+	}
+}
+
+// PickResources lets the user browse the resources that are about to be nuked, search them, leave out individual
+// resources or whole types, and view their properties. It returns the resources that are still selected, or nil if
+// the user cancelled.
+func PickResources(ctx context.Context, account *AwsAccountResources) (*AwsAccountResources, error) {
+	selection := newResourceSelection(account)
+
+	for {
+		if ctx.Err() != nil {
+			return nil, errors.WithStackTrace(ctx.Err())
+		}
+
+		confirm := fmt.Sprintf("Continue with the %d selected of %d resources", countSelected(selection.resources), len(selection.resources))
+		choice, err := pterm.DefaultInteractiveSelect.
+			WithOptions([]string{pickerBrowse, pickerSearch, pickerToggleType, pickerProperties, confirm, pickerCancel}).
+			Show("Choose the resources to nuke")
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		switch choice {
+		case pickerBrowse:
+			err = browseResources(selection)
+		case pickerSearch:
+			err = searchResources(selection)
+		case pickerToggleType:
+			err = toggleResourceTypes(selection)
+		case pickerProperties:
+			err = viewResourceProperties(ctx, selection)
+		case confirm:
+			return selection.selected(), nil
+		case pickerCancel:
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func browseResources(selection *resourceSelection) error {
+	region, err := pterm.DefaultInteractiveSelect.
+		WithOptions(selection.regions()).
+		WithMaxHeight(pickerMaxHeight).
+		Show("Region")
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	options := []string{}
+	typeNames := map[string]string{}
+	for _, typeName := range selection.typeNames(region) {
+		resources := selection.filter(region, typeName)
+		option := fmt.Sprintf("%s (%d of %d selected)", typeName, countSelected(resources), len(resources))
+		options = append(options, option)
+		typeNames[option] = typeName
+	}
+
+	option, err := pterm.DefaultInteractiveSelect.
+		WithOptions(options).
+		WithMaxHeight(pickerMaxHeight).
+		Show("Resource type")
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	identifier := func(resource *selectableResource) string { return resource.Identifier }
+	return chooseResources(selection.filter(region, typeNames[option]), identifier, "Resources to nuke")
+}
+
+func searchResources(selection *resourceSelection) error {
+	query, err := pterm.DefaultInteractiveTextInput.Show("Search by region, type or identifier")
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	matches := selection.search(query)
+	if len(matches) == 0 {
+		pterm.Warning.Printfln("No resources match %q", query)
+		return nil
+	}
+
+	label := func(resource *selectableResource) string { return resource.label() }
+	return chooseResources(matches, label, fmt.Sprintf("Resources matching %q to nuke", query))
+}
+
+// chooseResources shows a multiselect of the resources, with the selected ones checked
+func chooseResources(resources []*selectableResource, label func(*selectableResource) string, text string) error {
+	options, defaults := []string{}, []string{}
+	for _, resource := range resources {
+		options = append(options, label(resource))
+		if resource.Selected {
+			defaults = append(defaults, label(resource))
+		}
+	}
+
+	choices, err := pterm.DefaultInteractiveMultiselect.
+		WithOptions(options).
+		WithDefaultOptions(defaults).
+		WithMaxHeight(pickerMaxHeight).
+		Show(text)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	applyChoices(resources, label, choices)
+	return nil
+}
+
+func toggleResourceTypes(selection *resourceSelection) error {
+	options, defaults := selection.typeNames(""), []string{}
+	for _, typeName := range options {
+		if countSelected(selection.filter("", typeName)) > 0 {
+			defaults = append(defaults, typeName)
+		}
+	}
+
+	typeNames, err := pterm.DefaultInteractiveMultiselect.
+		WithOptions(options).
+		WithDefaultOptions(defaults).
+		WithMaxHeight(pickerMaxHeight).
+		Show("Resource types to nuke")
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	selection.setTypes(typeNames)
+	return nil
+}
+
+func viewResourceProperties(ctx context.Context, selection *resourceSelection) error {
+	options := []string{}
+	resources := map[string]*selectableResource{}
+	for _, resource := range selection.resources {
+		options = append(options, resource.label())
+		resources[resource.label()] = resource
+	}
+
+	choice, err := pterm.DefaultInteractiveSelect.
+		WithOptions(options).
+		WithMaxHeight(pickerMaxHeight).
+		Show("Resource")
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	resource := resources[choice]
+
+	config, err := newConfig(ctx, sessionRegion(ctx, resource.Region))
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	properties, err := describeResource(ctx, config, clientsFrom(ctx).CloudControl(config), resource.TypeName, resource.Identifier)
+	if err != nil {
+		pterm.Warning.Printfln("Could not read the properties of %s: %s", resource.Identifier, err)
+		return nil
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, []byte(properties), "", "  "); err != nil {
+		indented.Reset()
+		indented.WriteString(properties)
+	}
+
+	renderSection(colorTypeAndIdentifier(resource.TypeName, resource.Identifier))
+	pterm.Println(indented.String())
+	pterm.Println()
+	return nil
+}
@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testResourceSelection() *resourceSelection {
+	account := &AwsAccountResources{Resources: map[string]AwsRegionResource{}}
+	account.add("us-east-1", "AWS::Logs::LogGroup", "app-logs")
+	account.add("us-east-1", "AWS::Logs::LogGroup", "audit-logs")
+	account.add("us-east-1", "AWS::SNS::Topic", "alerts")
+	account.add("eu-west-1", "AWS::Logs::LogGroup", "app-logs")
+	return newResourceSelection(account)
+}
+
+func TestResourceSelectionBrowse(t *testing.T) {
+	t.Parallel()
+
+	selection := testResourceSelection()
+	assert.Equal(t, []string{"eu-west-1", "us-east-1"}, selection.regions())
+	assert.Equal(t, []string{"AWS::Logs::LogGroup", "AWS::SNS::Topic"}, selection.typeNames("us-east-1"))
+	assert.Equal(t, []string{"AWS::Logs::LogGroup"}, selection.typeNames("eu-west-1"))
+	assert.Len(t, selection.filter("us-east-1", "AWS::Logs::LogGroup"), 2)
+	assert.Equal(t, 4, countSelected(selection.resources))
+}
+
+func TestResourceSelectionSearchAndToggle(t *testing.T) {
+	t.Parallel()
+
+	selection := testResourceSelection()
+	matches := selection.search(" APP-")
+	require.Len(t, matches, 2)
+
+	// Deselecting one of the matches leaves the other resources alone
+	applyChoices(matches, (*selectableResource).label, []string{"eu-west-1 | AWS::Logs::LogGroup | app-logs"})
+	assert.Equal(t, 3, countSelected(selection.resources))
+
+	selected := selection.selected()
+	assert.Equal(t, []string{"audit-logs", "alerts"}, selectedIdentifiers(selected.Resources["us-east-1"]))
+	assert.Equal(t, []string{"app-logs"}, selectedIdentifiers(selected.Resources["eu-west-1"]))
+}
+
+func TestResourceSelectionSetTypes(t *testing.T) {
+	t.Parallel()
+
+	selection := testResourceSelection()
+	selection.setTypes([]string{"AWS::SNS::Topic"})
+	assert.Equal(t, 1, countSelected(selection.resources))
+
+	// Turning a type back on selects all of its resources
+	selection.setTypes([]string{"AWS::SNS::Topic", "AWS::Logs::LogGroup"})
+	assert.Equal(t, 4, countSelected(selection.resources))
+
+	// A type that is partly selected keeps its selection
+	setSelected(selection.filter("eu-west-1", ""), false)
+	selection.setTypes([]string{"AWS::SNS::Topic", "AWS::Logs::LogGroup"})
+	assert.Equal(t, 3, countSelected(selection.resources))
+}
+
+func selectedIdentifiers(resourcesInRegion AwsRegionResource) []string {
+	identifiers := []string{}
+	for _, resources := range resourcesInRegion.Resources {
+		identifiers = append(identifiers, resources.ResourceIdentifiers()...)
+	}
+	return identifiers
+}
@@ -0,0 +1,267 @@
+package aws
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/go-commons/collections"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/pterm/pterm"
+)
+
+// NukePlanVersion is the version of the plan file format written by WriteNukePlan
+const NukePlanVersion = 1
+
+// NukePlan is the exact list of resources that `cloud-nuke apply` deletes, written by `cloud-nuke plan`
+type NukePlan struct {
+	Version   int               `json:"version"`
+	CreatedAt time.Time         `json:"created_at"`
+	AccountID string            `json:"account_id,omitempty"`
+	Resources []PlannedResource `json:"resources"`
+}
+
+// PlannedResource is a resource in a plan. PropertiesHash is the hash of the resource model when the plan was made, so
+// that changes since can be detected. It is empty for resources that can't be described.
+type PlannedResource struct {
+	Region         string `json:"region"`
+	TypeName       string `json:"type_name"`
+	Identifier     string `json:"identifier"`
+	AccountID      string `json:"account_id,omitempty"`
+	PropertiesHash string `json:"properties_hash,omitempty"`
+}
+
+// PlanDrift is a planned resource that changed since the plan was made
+type PlanDrift struct {
+	Resource PlannedResource
+	Reason   string
+	// Dropped is set when the resource is left out of the apply, because it no longer exists
+	Dropped bool
+}
+
+// CreateNukePlan records every resource in the account, with the hash of its current model
+func CreateNukePlan(ctx context.Context, account *AwsAccountResources) (*NukePlan, error) {
+	plan := &NukePlan{
+		Version:   NukePlanVersion,
+		CreatedAt: time.Now().UTC(),
+		Resources: []PlannedResource{},
+	}
+
+	for region, resourcesInRegion := range account.Resources {
+		config, err := newConfig(ctx, sessionRegion(ctx, region))
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if plan.AccountID == "" {
+			plan.AccountID = currentAccountID(ctx, config)
+		}
+		svc := clientsFrom(ctx).CloudControl(config)
+
+		for _, resources := range resourcesInRegion.Resources {
+			for _, identifier := range resources.ResourceIdentifiers() {
+				if ctx.Err() != nil {
+					return nil, errors.WithStackTrace(ctx.Err())
+				}
+
+				resource := PlannedResource{
+					Region:     region,
+					TypeName:   resources.ResourceName(),
+					Identifier: identifier,
+				}
+
+				properties, err := describeResource(ctx, config, svc, resource.TypeName, identifier)
+				if err != nil {
+					logging.Logger.Debugf("Could not describe %s %s, so drift can't be detected for it: %s", resource.TypeName, identifier, err)
+				} else {
+					resource.PropertiesHash = propertiesHash(properties)
+				}
+				plan.Resources = append(plan.Resources, resource)
+			}
+		}
+	}
+
+	for i := range plan.Resources {
+		plan.Resources[i].AccountID = plan.AccountID
+	}
+	sort.Slice(plan.Resources, func(i, j int) bool {
+		a, b := plan.Resources[i], plan.Resources[j]
+		if a.Region != b.Region {
+			return a.Region < b.Region
+		}
+		if a.TypeName != b.TypeName {
+			return a.TypeName < b.TypeName
+		}
+		return a.Identifier < b.Identifier
+	})
+	return plan, nil
+}
+
+// propertiesHash returns the SHA-256 of a resource model. The model is re-encoded first, so that the hash does not
+// depend on the order of its keys or on whitespace.
+func propertiesHash(properties string) string {
+	contents := []byte(properties)
+
+	var model interface{}
+	if err := json.Unmarshal(contents, &model); err == nil {
+		if canonical, err := json.Marshal(model); err == nil {
+			contents = canonical
+		}
+	}
+
+	hash := sha256.Sum256(contents)
+	return hex.EncodeToString(hash[:])
+}
+
+// WriteNukePlan writes a plan file
+func WriteNukePlan(path string, plan *NukePlan) error {
+	contents, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return errors.WithStackTrace(ioutil.WriteFile(path, contents, 0644))
+}
+
+// ReadNukePlan reads a plan file written by WriteNukePlan
+func ReadNukePlan(path string) (*NukePlan, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	plan := &NukePlan{}
+	if err := json.Unmarshal(contents, plan); err != nil {
+		return nil, errors.WithStackTrace(InvalidNukePlanError{Path: path, Reason: err.Error()})
+	}
+	if plan.Version != NukePlanVersion {
+		return nil, errors.WithStackTrace(InvalidNukePlanError{Path: path, Reason: fmt.Sprintf("unsupported version %d", plan.Version)})
+	}
+	return plan, nil
+}
+
+// Regions returns the regions the planned resources are in
+func (plan *NukePlan) Regions() []string {
+	regions := []string{}
+	for _, resource := range plan.Resources {
+		if !collections.ListContainsElement(regions, resource.Region) {
+			regions = append(regions, resource.Region)
+		}
+	}
+	return regions
+}
+
+// CheckNukePlan refuses plans older than maxAge or made for another account, then looks up every planned resource
+// again. It returns the resources to delete, which leave out those that no longer exist, and every resource that
+// drifted since the plan was made.
+func CheckNukePlan(ctx context.Context, plan *NukePlan, maxAge time.Duration, now time.Time) (*AwsAccountResources, []PlanDrift, error) {
+	account := &AwsAccountResources{
+		Resources: make(map[string]AwsRegionResource),
+	}
+	drift := []PlanDrift{}
+
+	checkedAccount := false
+	for _, region := range plan.Regions() {
+		config, err := newConfig(ctx, sessionRegion(ctx, region))
+		if err != nil {
+			return nil, nil, errors.WithStackTrace(err)
+		}
+
+		if !checkedAccount {
+			if err := checkNukePlanFreshness(plan, currentAccountID(ctx, config), maxAge, now); err != nil {
+				return nil, nil, err
+			}
+			checkedAccount = true
+		}
+
+		svc := clientsFrom(ctx).CloudControl(config)
+		for _, resource := range plan.Resources {
+			if resource.Region != region {
+				continue
+			}
+			if ctx.Err() != nil {
+				return nil, nil, errors.WithStackTrace(ctx.Err())
+			}
+
+			properties, err := describeResource(ctx, config, svc, resource.TypeName, resource.Identifier)
+			if resourceDrift, ok := comparePlannedResource(resource, properties, err); ok {
+				drift = append(drift, resourceDrift)
+				if resourceDrift.Dropped {
+					continue
+				}
+			}
+			account.add(resource.Region, resource.TypeName, resource.Identifier)
+		}
+	}
+
+	return account, drift, nil
+}
+
+// checkNukePlanFreshness returns a StaleNukePlanError if the plan is older than maxAge, or was made for an account
+// other than accountID. As plans are only applied to the account they were made for, either account being unknown is
+// also an error. A zero maxAge accepts plans of any age.
+func checkNukePlanFreshness(plan *NukePlan, accountID string, maxAge time.Duration, now time.Time) error {
+	if age := now.Sub(plan.CreatedAt); maxAge > 0 && age > maxAge {
+		return errors.WithStackTrace(StaleNukePlanError{Reason: fmt.Sprintf("it was made %s ago, which is more than %s", age.Round(time.Second), maxAge)})
+	}
+	if plan.AccountID == "" {
+		return errors.WithStackTrace(StaleNukePlanError{Reason: "it does not record the account it was made for"})
+	}
+	if accountID == "" {
+		return errors.WithStackTrace(StaleNukePlanError{Reason: "the account of the current credentials could not be determined"})
+	}
+	if plan.AccountID != accountID {
+		return errors.WithStackTrace(StaleNukePlanError{Reason: fmt.Sprintf("it was made for account %s, but the current credentials are for account %s", plan.AccountID, accountID)})
+	}
+	return nil
+}
+
+// comparePlannedResource compares the current model of a planned resource, or the error looking it up, with the plan
+func comparePlannedResource(resource PlannedResource, properties string, err error) (PlanDrift, bool) {
+	if err != nil {
+		// describeResource adds a stack trace, which errors.As can't see through
+		var notFound *types.ResourceNotFoundException
+		if stderrors.As(errors.Unwrap(err), &notFound) {
+			return PlanDrift{Resource: resource, Reason: "no longer exists", Dropped: true}, true
+		}
+		if resource.PropertiesHash == "" {
+			return PlanDrift{}, false
+		}
+		return PlanDrift{Resource: resource, Reason: fmt.Sprintf("could not be looked up: %s", err)}, true
+	}
+
+	if resource.PropertiesHash != "" && propertiesHash(properties) != resource.PropertiesHash {
+		return PlanDrift{Resource: resource, Reason: "changed since the plan was made"}, true
+	}
+	return PlanDrift{}, false
+}
+
+// RenderPlanDrift prints the planned resources that drifted since the plan was made
+func RenderPlanDrift(drift []PlanDrift) {
+	if len(drift) == 0 {
+		return
+	}
+
+	tableData := pterm.TableData{{"Region", "Resource", "Drift"}}
+	for _, resourceDrift := range drift {
+		tableData = append(tableData, []string{
+			resourceDrift.Resource.Region,
+			colorTypeAndIdentifier(resourceDrift.Resource.TypeName, resourceDrift.Resource.Identifier),
+			resourceDrift.Reason,
+		})
+	}
+
+	pterm.Println()
+	renderSection("Drift since the plan was made")
+	pterm.DefaultTable.
+		WithHasHeader().
+		WithData(tableData).
+		Render()
+	pterm.Println()
+}
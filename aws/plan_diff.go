@@ -0,0 +1,240 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// ResourceChangeKind is how a resource differs between two plans
+type ResourceChangeKind string
+
+const (
+	// ResourceAdded - the resource is only in the second plan
+	ResourceAdded ResourceChangeKind = "added"
+	// ResourceRemoved - the resource is only in the first plan
+	ResourceRemoved ResourceChangeKind = "removed"
+	// ResourceChanged - the resource is in both plans, but its properties hash differs
+	ResourceChanged ResourceChangeKind = "changed"
+)
+
+// DiffFormats are the formats a NukePlanDiff can be written in
+var DiffFormats = []string{"text", "json", "markdown"}
+
+// NukePlanDiff is the list of resources that differ between two plans, sorted by region, type and identifier
+type NukePlanDiff struct {
+	Added   int              `json:"added"`
+	Removed int              `json:"removed"`
+	Changed int              `json:"changed"`
+	Changes []ResourceChange `json:"changes"`
+}
+
+// ResourceChange is a resource that differs between two plans
+type ResourceChange struct {
+	Change     ResourceChangeKind `json:"change"`
+	Region     string             `json:"region"`
+	TypeName   string             `json:"type_name"`
+	Identifier string             `json:"identifier"`
+	AccountID  string             `json:"account_id,omitempty"`
+}
+
+// ReadInventory reads the resources listed by a plan file written by `plan`, a run file, or a backup archive, as a
+// plan. The kind of file is detected from its contents. The resources of a run file have no properties hash, so they
+// are never reported as changed; those of a backup archive are hashed like the resources of a plan. The key is only
+// needed for encrypted backup archives.
+func ReadInventory(path string, key []byte) (*NukePlan, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	if bytes.HasPrefix(contents, []byte(encryptedBackupMagic)) || bytes.HasPrefix(contents, gzipMagic) {
+		backup, err := ReadBackup(path, key)
+		if err != nil {
+			return nil, err
+		}
+		return backupInventory(backup), nil
+	}
+
+	var probe struct {
+		Entries json.RawMessage `json:"entries"`
+	}
+	if err := json.Unmarshal(contents, &probe); err == nil && probe.Entries != nil {
+		run, err := LoadRun(path)
+		if err != nil {
+			return nil, err
+		}
+		return runInventory(run), nil
+	}
+
+	return ReadNukePlan(path)
+}
+
+// gzipMagic starts every gzip stream, such as the contents of unencrypted backup archives
+var gzipMagic = []byte{0x1f, 0x8b}
+
+func backupInventory(backup *Backup) *NukePlan {
+	plan := &NukePlan{
+		Version:   NukePlanVersion,
+		CreatedAt: backup.CreatedAt,
+		AccountID: backup.AccountID,
+		Resources: []PlannedResource{},
+	}
+	for _, resource := range backup.Resources {
+		planned := PlannedResource{Region: resource.Region, TypeName: resource.TypeName, Identifier: resource.Identifier}
+		if resource.Error == "" && len(resource.Properties) > 0 {
+			planned.PropertiesHash = propertiesHash(string(resource.Properties))
+		}
+		plan.Resources = append(plan.Resources, planned)
+	}
+	return plan
+}
+
+func runInventory(run *Run) *NukePlan {
+	plan := &NukePlan{
+		Version:   NukePlanVersion,
+		CreatedAt: run.StartedAt,
+		Resources: []PlannedResource{},
+	}
+	for _, entry := range run.Entries {
+		plan.Resources = append(plan.Resources, PlannedResource{Region: entry.Region, TypeName: entry.TypeName, Identifier: entry.Identifier})
+	}
+	return plan
+}
+
+// DiffNukePlans compares two plans. Resources are the same when they have the same account, region, type and
+// identifier; they are changed when both plans have a hash of their properties and the hashes differ.
+func DiffNukePlans(from, to *NukePlan) *NukePlanDiff {
+	key := func(resource PlannedResource) string {
+		return strings.Join([]string{resource.AccountID, resource.Region, resource.TypeName, resource.Identifier}, "\x00")
+	}
+
+	before := map[string]PlannedResource{}
+	for _, resource := range from.Resources {
+		before[key(resource)] = resource
+	}
+
+	diff := &NukePlanDiff{Changes: []ResourceChange{}}
+	seen := map[string]bool{}
+	for _, resource := range to.Resources {
+		seen[key(resource)] = true
+		previous, ok := before[key(resource)]
+		switch {
+		case !ok:
+			diff.add(ResourceAdded, resource)
+		case previous.PropertiesHash != "" && resource.PropertiesHash != "" && previous.PropertiesHash != resource.PropertiesHash:
+			diff.add(ResourceChanged, resource)
+		}
+	}
+	for _, resource := range from.Resources {
+		if !seen[key(resource)] {
+			diff.add(ResourceRemoved, resource)
+		}
+	}
+
+	sort.SliceStable(diff.Changes, func(i, j int) bool {
+		a, b := diff.Changes[i], diff.Changes[j]
+		if a.Region != b.Region {
+			return a.Region < b.Region
+		}
+		if a.TypeName != b.TypeName {
+			return a.TypeName < b.TypeName
+		}
+		return a.Identifier < b.Identifier
+	})
+	return diff
+}
+
+func (diff *NukePlanDiff) add(kind ResourceChangeKind, resource PlannedResource) {
+	switch kind {
+	case ResourceAdded:
+		diff.Added++
+	case ResourceRemoved:
+		diff.Removed++
+	case ResourceChanged:
+		diff.Changed++
+	}
+	diff.Changes = append(diff.Changes, ResourceChange{
+		Change:     kind,
+		Region:     resource.Region,
+		TypeName:   resource.TypeName,
+		Identifier: resource.Identifier,
+		AccountID:  resource.AccountID,
+	})
+}
+
+// Summary returns the number of added, removed and changed resources
+func (diff *NukePlanDiff) Summary() string {
+	return fmt.Sprintf("%d added, %d removed, %d changed", diff.Added, diff.Removed, diff.Changed)
+}
+
+// WriteNukePlanDiff writes the diff in one of the DiffFormats
+func WriteNukePlanDiff(w io.Writer, diff *NukePlanDiff, format string) error {
+	switch format {
+	case "text":
+		return writeDiffText(w, diff)
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return errors.WithStackTrace(encoder.Encode(diff))
+	case "markdown":
+		return writeDiffMarkdown(w, diff)
+	}
+	return errors.WithStackTrace(fmt.Errorf("unknown diff format %q", format))
+}
+
+var diffSymbols = map[ResourceChangeKind]string{
+	ResourceAdded:   "+",
+	ResourceRemoved: "-",
+	ResourceChanged: "~",
+}
+
+func writeDiffText(w io.Writer, diff *NukePlanDiff) error {
+	var out strings.Builder
+	region, typeName := "", ""
+	for i, change := range diff.Changes {
+		if i == 0 || change.Region != region {
+			region, typeName = change.Region, ""
+			fmt.Fprintf(&out, "%s\n", region)
+		}
+		if change.TypeName != typeName {
+			typeName = change.TypeName
+			fmt.Fprintf(&out, "  %s\n", typeName)
+		}
+		fmt.Fprintf(&out, "    %s %s\n", diffSymbols[change.Change], change.Identifier)
+	}
+	fmt.Fprintf(&out, "%s\n", diff.Summary())
+
+	_, err := io.WriteString(w, out.String())
+	return errors.WithStackTrace(err)
+}
+
+func writeDiffMarkdown(w io.Writer, diff *NukePlanDiff) error {
+	var out strings.Builder
+	fmt.Fprintf(&out, "**%s**\n", diff.Summary())
+
+	region, typeName := "", ""
+	for i, change := range diff.Changes {
+		if i == 0 || change.Region != region {
+			region, typeName = change.Region, ""
+			fmt.Fprintf(&out, "\n### %s\n", region)
+		}
+		if change.TypeName != typeName {
+			typeName = change.TypeName
+			fmt.Fprintf(&out, "\n#### `%s`\n\n| Change | Identifier |\n| --- | --- |\n", typeName)
+		}
+		fmt.Fprintf(&out, "| %s | `%s` |\n", change.Change, markdownEscaper.Replace(change.Identifier))
+	}
+
+	_, err := io.WriteString(w, out.String())
+	return errors.WithStackTrace(err)
+}
+
+// markdownEscaper keeps identifiers, such as ARNs, from breaking the table they are in
+var markdownEscaper = strings.NewReplacer("|", "\\|", "`", "'")
@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPlanDiff() *NukePlanDiff {
+	from := &NukePlan{Resources: []PlannedResource{
+		{Region: "us-east-1", TypeName: "AWS::Logs::LogGroup", Identifier: "kept", PropertiesHash: "1"},
+		{Region: "us-east-1", TypeName: "AWS::Logs::LogGroup", Identifier: "changed", PropertiesHash: "1"},
+		{Region: "us-east-1", TypeName: "AWS::Logs::LogGroup", Identifier: "removed"},
+		{Region: "eu-west-1", TypeName: "AWS::SNS::Topic", Identifier: "undescribed"},
+	}}
+	to := &NukePlan{Resources: []PlannedResource{
+		{Region: "us-east-1", TypeName: "AWS::Logs::LogGroup", Identifier: "kept", PropertiesHash: "1"},
+		{Region: "us-east-1", TypeName: "AWS::Logs::LogGroup", Identifier: "changed", PropertiesHash: "2"},
+		{Region: "us-east-1", TypeName: "AWS::SQS::Queue", Identifier: "added"},
+		{Region: "eu-west-1", TypeName: "AWS::SNS::Topic", Identifier: "undescribed", PropertiesHash: "1"},
+	}}
+	return DiffNukePlans(from, to)
+}
+
+func TestDiffNukePlans(t *testing.T) {
+	t.Parallel()
+
+	diff := testPlanDiff()
+	assert.Equal(t, 1, diff.Added)
+	assert.Equal(t, 1, diff.Removed)
+	assert.Equal(t, 1, diff.Changed)
+	assert.Equal(t, []ResourceChange{
+		{Change: ResourceChanged, Region: "us-east-1", TypeName: "AWS::Logs::LogGroup", Identifier: "changed"},
+		{Change: ResourceRemoved, Region: "us-east-1", TypeName: "AWS::Logs::LogGroup", Identifier: "removed"},
+		{Change: ResourceAdded, Region: "us-east-1", TypeName: "AWS::SQS::Queue", Identifier: "added"},
+	}, diff.Changes)
+}
+
+func TestWriteNukePlanDiff(t *testing.T) {
+	t.Parallel()
+
+	diff := testPlanDiff()
+
+	var text bytes.Buffer
+	require.NoError(t, WriteNukePlanDiff(&text, diff, "text"))
+	assert.Equal(t, `us-east-1
+  AWS::Logs::LogGroup
+    ~ changed
+    - removed
+  AWS::SQS::Queue
+    + added
+1 added, 1 removed, 1 changed
+`, text.String())
+
+	var markdown bytes.Buffer
+	require.NoError(t, WriteNukePlanDiff(&markdown, diff, "markdown"))
+	assert.Contains(t, markdown.String(), "### us-east-1\n\n#### `AWS::Logs::LogGroup`\n\n| Change | Identifier |\n| --- | --- |\n| changed | `changed` |\n| removed | `removed` |\n")
+	assert.Contains(t, markdown.String(), "#### `AWS::SQS::Queue`\n\n| Change | Identifier |\n| --- | --- |\n| added | `added` |\n")
+
+	var encoded bytes.Buffer
+	require.NoError(t, WriteNukePlanDiff(&encoded, diff, "json"))
+	decoded := &NukePlanDiff{}
+	require.NoError(t, json.Unmarshal(encoded.Bytes(), decoded))
+	assert.Equal(t, diff, decoded)
+
+	assert.Error(t, WriteNukePlanDiff(&text, diff, "yaml"))
+}
+
+func TestReadInventory(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	key := make([]byte, backupKeySize)
+
+	planPath := filepath.Join(dir, "plan.json")
+	require.NoError(t, WriteNukePlan(planPath, &NukePlan{
+		Version:   NukePlanVersion,
+		AccountID: "123456789012",
+		Resources: []PlannedResource{
+			{Region: "us-east-1", TypeName: "AWS::Logs::LogGroup", Identifier: "group-a", PropertiesHash: propertiesHash(`{"LogGroupName":"group-a"}`)},
+		},
+	}))
+
+	run := NewRun(filepath.Join(dir, "run.json"))
+	require.NoError(t, run.Plan(&AwsAccountResources{Resources: map[string]AwsRegionResource{
+		"us-east-1": {Resources: []*AwsResource{{TypeName: "AWS::Logs::LogGroup", Identifiers: []string{"group-a", "group-b"}}}},
+	}}))
+
+	backupPath := filepath.Join(dir, "backup.json.gz")
+	require.NoError(t, WriteBackup(backupPath, &Backup{
+		AccountID: "123456789012",
+		Resources: []BackupResource{
+			{Region: "us-east-1", TypeName: "AWS::Logs::LogGroup", Identifier: "group-a", Properties: json.RawMessage(`{"LogGroupName": "group-a"}`)},
+			{Region: "us-east-1", TypeName: "AWS::Logs::LogGroup", Identifier: "group-c", Error: "AccessDenied"},
+		},
+	}, key))
+
+	plan, err := ReadInventory(planPath, nil)
+	require.NoError(t, err)
+	fromRun, err := ReadInventory(run.Path(), nil)
+	require.NoError(t, err)
+	fromBackup, err := ReadInventory(backupPath, key)
+	require.NoError(t, err)
+
+	assert.Equal(t, []PlannedResource{
+		{Region: "us-east-1", TypeName: "AWS::Logs::LogGroup", Identifier: "group-a"},
+		{Region: "us-east-1", TypeName: "AWS::Logs::LogGroup", Identifier: "group-b"},
+	}, fromRun.Resources)
+	assert.Equal(t, "123456789012", fromBackup.AccountID)
+
+	// The backup hashes the same model as the plan, so the resource is unchanged
+	diff := DiffNukePlans(plan, fromBackup)
+	assert.Equal(t, []ResourceChange{
+		{Change: ResourceAdded, Region: "us-east-1", TypeName: "AWS::Logs::LogGroup", Identifier: "group-c"},
+	}, diff.Changes)
+
+	_, err = ReadInventory(backupPath, nil)
+	_, ok := errors.Unwrap(err).(InvalidBackupError)
+	assert.True(t, ok, err)
+}
@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	goerrors "github.com/gruntwork-io/go-commons/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNukePlanWriteAndRead(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "plan.json")
+	plan := &NukePlan{
+		Version:   NukePlanVersion,
+		CreatedAt: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+		AccountID: "123456789012",
+		Resources: []PlannedResource{
+			{Region: "us-east-1", TypeName: "AWS::Logs::LogGroup", Identifier: "my-log-group", AccountID: "123456789012", PropertiesHash: "abc"},
+			{Region: GlobalRegion, TypeName: "AWS::IAM::Role", Identifier: "my-role", AccountID: "123456789012"},
+		},
+	}
+	require.NoError(t, WriteNukePlan(path, plan))
+
+	loaded, err := ReadNukePlan(path)
+	require.NoError(t, err)
+	assert.Equal(t, plan, loaded)
+	assert.Equal(t, []string{"us-east-1", GlobalRegion}, loaded.Regions())
+}
+
+func TestReadNukePlanRejectsUnknownVersion(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "plan.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"version": 99, "resources": []}`), 0644))
+
+	_, err := ReadNukePlan(path)
+	require.Error(t, err)
+	_, ok := goerrors.Unwrap(err).(InvalidNukePlanError)
+	assert.True(t, ok)
+}
+
+func TestPropertiesHashIgnoresKeyOrder(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t,
+		propertiesHash(`{"LogGroupName": "my-log-group", "RetentionInDays": 7}`),
+		propertiesHash(`{"RetentionInDays":7,"LogGroupName":"my-log-group"}`),
+	)
+	assert.NotEqual(t,
+		propertiesHash(`{"LogGroupName": "my-log-group", "RetentionInDays": 7}`),
+		propertiesHash(`{"LogGroupName": "my-log-group", "RetentionInDays": 14}`),
+	)
+}
+
+func TestCheckNukePlanFreshness(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	plan := &NukePlan{CreatedAt: now.Add(-2 * time.Hour), AccountID: "123456789012"}
+
+	tests := []struct {
+		name      string
+		accountID string
+		maxAge    time.Duration
+		stale     bool
+	}{
+		{"Fresh", "123456789012", 24 * time.Hour, false},
+		{"TooOld", "123456789012", time.Hour, true},
+		{"AnyAge", "123456789012", 0, false},
+		{"OtherAccount", "210987654321", 24 * time.Hour, true},
+		{"UnknownAccount", "", 24 * time.Hour, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := checkNukePlanFreshness(plan, tt.accountID, tt.maxAge, now)
+			if !tt.stale {
+				assert.NoError(t, err)
+				return
+			}
+			_, ok := goerrors.Unwrap(err).(StaleNukePlanError)
+			assert.True(t, ok)
+		})
+	}
+}
+
+func TestCheckNukePlanFreshnessRejectsPlansWithoutAccount(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	err := checkNukePlanFreshness(&NukePlan{CreatedAt: now}, "123456789012", 0, now)
+	_, ok := goerrors.Unwrap(err).(StaleNukePlanError)
+	assert.True(t, ok)
+}
+
+func TestComparePlannedResource(t *testing.T) {
+	t.Parallel()
+
+	properties := `{"LogGroupName":"my-log-group"}`
+	resource := PlannedResource{Region: "us-east-1", TypeName: "AWS::Logs::LogGroup", Identifier: "my-log-group", PropertiesHash: propertiesHash(properties)}
+	undescribed := resource
+	undescribed.PropertiesHash = ""
+
+	tests := []struct {
+		name       string
+		resource   PlannedResource
+		properties string
+		err        error
+		drifted    bool
+		dropped    bool
+	}{
+		{"Unchanged", resource, properties, nil, false, false},
+		{"Changed", resource, `{"LogGroupName":"my-log-group","RetentionInDays":7}`, nil, true, false},
+		{"Deleted", resource, "", goerrors.WithStackTrace(&types.ResourceNotFoundException{}), true, true},
+		{"LookupFailed", resource, "", errors.New("AccessDenied"), true, false},
+		{"NotDescribable", undescribed, "", errors.New("AccessDenied"), false, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			drift, drifted := comparePlannedResource(tt.resource, tt.properties, tt.err)
+			assert.Equal(t, tt.drifted, drifted)
+			assert.Equal(t, tt.dropped, drift.Dropped)
+		})
+	}
+}
+
+func TestLoadNukePlan(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "nuke-plan.yml")
+	require.NoError(t, ioutil.WriteFile(path, []byte("ResourcesToNuke:\n  - AWS::Logs::LogGroup\n  - AWS::SNS::Topic\n"), 0644))
+
+	resourcesToNuke, err := LoadNukePlan(path)
+	require.NoError(t, err)
+	assert.Equal(t, []ResourceTypeString{"AWS::Logs::LogGroup", "AWS::SNS::Topic"}, resourcesToNuke.Targets)
+}
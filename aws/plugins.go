@@ -0,0 +1,251 @@
+package aws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/go-commons/collections"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// PluginExecutablePrefix is the file name prefix of the executables that are loaded as plugins
+const PluginExecutablePrefix = "cloud-nuke-plugin-"
+
+// PluginProtocolVersion is the version of the plugin protocol spoken by this version of cloud-nuke
+const PluginProtocolVersion = 1
+
+// Plugin is an executable that cloud-nuke runs to list, describe and delete resource types it doesn't know about.
+//
+// Each call runs the executable once with the method as its only argument, writes a PluginRequest as JSON to its
+// stdin, and reads a PluginResponse as JSON from its stdout. A non-empty "error" in the response, or a non-zero exit
+// code, fails the call. The credentials and region cloud-nuke uses are passed in the standard AWS_* environment
+// variables, so plugins can use any AWS SDK. The other variables through which the SDKs find credentials, such as
+// AWS_PROFILE, are removed from the environment of plugins, so they can only use the credentials that cloud-nuke
+// passes them: those of the account being nuked, which are the same as the ones cloud-nuke itself uses.
+//
+// The methods are:
+//   - "types": returns the resource types the plugin handles in "resource_types"
+//   - "list": returns the resources of "type_name" in "region" in "resources"
+//   - "describe": returns the resource "identifier" of "type_name", with its properties, in "resources"
+//   - "delete": deletes the resource "identifier" of "type_name", returning once it is gone
+type Plugin struct {
+	Path string
+}
+
+// PluginRequest is written to the plugin's stdin
+type PluginRequest struct {
+	ProtocolVersion int    `json:"protocol_version"`
+	TypeName        string `json:"type_name,omitempty"`
+	Region          string `json:"region,omitempty"`
+	Identifier      string `json:"identifier,omitempty"`
+}
+
+// PluginResponse is read from the plugin's stdout
+type PluginResponse struct {
+	ResourceTypes []string         `json:"resource_types,omitempty"`
+	Resources     []PluginResource `json:"resources,omitempty"`
+	Error         string           `json:"error,omitempty"`
+}
+
+// PluginResource is a single resource returned by a plugin
+type PluginResource struct {
+	Identifier string          `json:"identifier"`
+	Properties json.RawMessage `json:"properties,omitempty"`
+}
+
+// DiscoverPlugins returns the plugin executables found in the given directories
+func DiscoverPlugins(dirs []string) ([]Plugin, error) {
+	plugins := []Plugin{}
+	for _, dir := range dirs {
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, file := range files {
+			if file.IsDir() || !strings.HasPrefix(file.Name(), PluginExecutablePrefix) || file.Mode()&0111 == 0 {
+				continue
+			}
+			plugins = append(plugins, Plugin{Path: filepath.Join(dir, file.Name())})
+		}
+	}
+	return plugins, nil
+}
+
+// pluginCredentialVariables are the environment variables through which the AWS SDKs find credentials, which are
+// removed from the environment of plugins
+var pluginCredentialVariables = []string{
+	"AWS_ACCESS_KEY_ID",
+	"AWS_SECRET_ACCESS_KEY",
+	"AWS_SESSION_TOKEN",
+	"AWS_SECURITY_TOKEN",
+	"AWS_PROFILE",
+	"AWS_DEFAULT_PROFILE",
+	"AWS_ROLE_ARN",
+	"AWS_ROLE_SESSION_NAME",
+	"AWS_WEB_IDENTITY_TOKEN_FILE",
+	"AWS_CONTAINER_CREDENTIALS_RELATIVE_URI",
+	"AWS_CONTAINER_CREDENTIALS_FULL_URI",
+	"AWS_CONTAINER_AUTHORIZATION_TOKEN",
+	"AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE",
+	"AWS_CREDENTIAL_EXPIRATION",
+}
+
+// LoadPlugins discovers the plugins in the given directories and registers a ResourceHandler for every resource type
+// they declare, so those types are scanned, reported and nuked like any other. A plugin can't declare a type that
+// already has a handler, such as a CloudNuke:: type or a type of another plugin.
+func LoadPlugins(ctx context.Context, dirs []string) error {
+	plugins, err := DiscoverPlugins(dirs)
+	if err != nil {
+		return err
+	}
+
+	for _, plugin := range plugins {
+		response, err := plugin.call(ctx, "types", PluginRequest{}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, typeName := range response.ResourceTypes {
+			if existing, ok := resourceHandlerFor(typeName); ok {
+				if existing == (pluginHandler{plugin: plugin, typeName: typeName}) {
+					// The plugin was already loaded
+					continue
+				}
+				return errors.WithStackTrace(DuplicateResourceTypeError{TypeName: typeName, PluginPath: plugin.Path, RegisteredBy: handlerOwner(existing)})
+			}
+			logging.Logger.Debugf("Plugin %s handles resource type %s", plugin.Path, typeName)
+			RegisterResourceHandler(pluginHandler{plugin: plugin, typeName: typeName})
+		}
+	}
+	return nil
+}
+
+// call runs the plugin for a single method. When config is set, its region and credentials are passed to the plugin.
+func (plugin Plugin) call(ctx context.Context, method string, request PluginRequest, config *aws.Config) (*PluginResponse, error) {
+	request.ProtocolVersion = PluginProtocolVersion
+	input, err := json.Marshal(request)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	cmd := exec.CommandContext(ctx, plugin.Path, method)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stderr = os.Stderr
+	cmd.Env = pluginBaseEnvironment(os.Environ())
+	if config != nil {
+		env, err := pluginEnvironment(ctx, *config)
+		if err != nil {
+			return nil, err
+		}
+		cmd.Env = append(cmd.Env, env...)
+	}
+
+	output, runErr := cmd.Output()
+
+	response := &PluginResponse{}
+	if err := json.Unmarshal(output, response); err != nil {
+		if runErr != nil {
+			return nil, errors.WithStackTrace(PluginError{Path: plugin.Path, Method: method, Message: runErr.Error()})
+		}
+		return nil, errors.WithStackTrace(PluginError{Path: plugin.Path, Method: method, Message: fmt.Sprintf("invalid response: %s", err)})
+	}
+	if response.Error != "" {
+		return nil, errors.WithStackTrace(PluginError{Path: plugin.Path, Method: method, Message: response.Error})
+	}
+	if runErr != nil {
+		return nil, errors.WithStackTrace(PluginError{Path: plugin.Path, Method: method, Message: runErr.Error()})
+	}
+
+	return response, nil
+}
+
+// pluginBaseEnvironment returns the environment of cloud-nuke without the variables of pluginCredentialVariables
+func pluginBaseEnvironment(environ []string) []string {
+	env := []string{}
+	for _, variable := range environ {
+		name := strings.SplitN(variable, "=", 2)[0]
+		if !collections.ListContainsElement(pluginCredentialVariables, name) {
+			env = append(env, variable)
+		}
+	}
+	return env
+}
+
+// pluginEnvironment returns the AWS_* environment variables that hand the config's region and credentials to a plugin
+func pluginEnvironment(ctx context.Context, config aws.Config) ([]string, error) {
+	env := []string{
+		"AWS_REGION=" + config.Region,
+		"AWS_DEFAULT_REGION=" + config.Region,
+	}
+	if config.Credentials == nil {
+		return env, nil
+	}
+
+	creds, err := config.Credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	env = append(env,
+		"AWS_ACCESS_KEY_ID="+creds.AccessKeyID,
+		"AWS_SECRET_ACCESS_KEY="+creds.SecretAccessKey,
+		"AWS_SESSION_TOKEN="+creds.SessionToken,
+	)
+	return env, nil
+}
+
+// pluginHandler is the ResourceHandler for a resource type declared by a plugin
+type pluginHandler struct {
+	plugin   Plugin
+	typeName string
+}
+
+// handlerOwner describes what registered a handler: a plugin, or cloud-nuke itself
+func handlerOwner(handler ResourceHandler) string {
+	if plugin, ok := handler.(pluginHandler); ok {
+		return "plugin " + plugin.plugin.Path
+	}
+	return "cloud-nuke"
+}
+
+func (handler pluginHandler) TypeName() string {
+	return handler.typeName
+}
+
+func (handler pluginHandler) List(ctx context.Context, config aws.Config) ([]string, error) {
+	response, err := handler.plugin.call(ctx, "list", PluginRequest{TypeName: handler.typeName, Region: config.Region}, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers := []string{}
+	for _, resource := range response.Resources {
+		identifiers = append(identifiers, resource.Identifier)
+	}
+	return identifiers, nil
+}
+
+func (handler pluginHandler) Describe(ctx context.Context, config aws.Config, identifier string) (string, error) {
+	response, err := handler.plugin.call(ctx, "describe", PluginRequest{TypeName: handler.typeName, Region: config.Region, Identifier: identifier}, &config)
+	if err != nil {
+		return "", err
+	}
+	if len(response.Resources) == 0 {
+		return "", errors.WithStackTrace(PluginError{Path: handler.plugin.Path, Method: "describe", Message: fmt.Sprintf("resource %s not found", identifier)})
+	}
+	return string(response.Resources[0].Properties), nil
+}
+
+func (handler pluginHandler) Delete(ctx context.Context, config aws.Config, identifier string) error {
+	_, err := handler.plugin.call(ctx, "delete", PluginRequest{TypeName: handler.typeName, Region: config.Region, Identifier: identifier}, &config)
+	return err
+}
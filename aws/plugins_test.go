@@ -0,0 +1,126 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPluginScript = `#!/bin/sh
+case "$1" in
+  types) echo '{"resource_types": ["Test::Plugin::Queue"]}' ;;
+  list) echo "{\"resources\": [{\"identifier\": \"queue-$AWS_REGION\"}]}" ;;
+  describe) echo '{"resources": [{"identifier": "queue-1", "properties": {"Name": "queue-1"}}]}' ;;
+  delete) echo '{"error": "queue is locked"}' ;;
+  *) exit 1 ;;
+esac
+`
+
+func writeTestPlugin(t *testing.T) string {
+	if runtime.GOOS == "windows" {
+		t.Skip("Test plugins are shell scripts")
+	}
+
+	dir := t.TempDir()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, PluginExecutablePrefix+"queues"), []byte(testPluginScript), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, PluginExecutablePrefix+"not-executable"), []byte(testPluginScript), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "other-tool"), []byte(testPluginScript), 0755))
+	return dir
+}
+
+func TestDiscoverPlugins(t *testing.T) {
+	t.Parallel()
+
+	dir := writeTestPlugin(t)
+
+	plugins, err := DiscoverPlugins([]string{dir})
+	require.NoError(t, err)
+	assert.Equal(t, []Plugin{{Path: filepath.Join(dir, PluginExecutablePrefix+"queues")}}, plugins)
+}
+
+func TestLoadPluginsRegistersHandlers(t *testing.T) {
+	t.Parallel()
+
+	dir := writeTestPlugin(t)
+	ctx := context.Background()
+	config := aws.Config{Region: "eu-west-1"}
+
+	require.NoError(t, LoadPlugins(ctx, []string{dir}))
+	handler, ok := resourceHandlerFor("Test::Plugin::Queue")
+	require.True(t, ok)
+
+	identifiers, err := handler.List(ctx, config)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"queue-eu-west-1"}, identifiers)
+
+	properties, err := describeResource(ctx, config, nil, "Test::Plugin::Queue", "queue-1")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"Name": "queue-1"}`, properties)
+
+	err = handler.Delete(ctx, config, "queue-1")
+	pluginErr, ok := errors.Unwrap(err).(PluginError)
+	require.True(t, ok, err)
+	assert.Equal(t, "delete", pluginErr.Method)
+	assert.Equal(t, "queue is locked", pluginErr.Message)
+}
+
+// writePlugin writes a plugin that only declares typeName
+func writePlugin(t *testing.T, dir, name, typeName string) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Test plugins are shell scripts")
+	}
+
+	script := fmt.Sprintf("#!/bin/sh\necho '{\"resource_types\": [\"%s\"]}'\n", typeName)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, PluginExecutablePrefix+name), []byte(script), 0755))
+}
+
+func TestLoadPluginsRejectsDuplicateTypes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	builtIn := t.TempDir()
+	writePlugin(t, builtIn, "snapshots", EBSSnapshots{}.TypeName())
+	err := LoadPlugins(ctx, []string{builtIn})
+	duplicateErr, ok := errors.Unwrap(err).(DuplicateResourceTypeError)
+	require.True(t, ok, err)
+	assert.Equal(t, DuplicateResourceTypeError{TypeName: EBSSnapshots{}.TypeName(), PluginPath: filepath.Join(builtIn, PluginExecutablePrefix+"snapshots"), RegisteredBy: "cloud-nuke"}, duplicateErr)
+	handler, _ := resourceHandlerFor(EBSSnapshots{}.TypeName())
+	assert.Equal(t, EBSSnapshots{}, handler)
+
+	plugins := t.TempDir()
+	writePlugin(t, plugins, "a", "Test::Plugin::Duplicate")
+	writePlugin(t, plugins, "b", "Test::Plugin::Duplicate")
+	err = LoadPlugins(ctx, []string{plugins})
+	duplicateErr, ok = errors.Unwrap(err).(DuplicateResourceTypeError)
+	require.True(t, ok, err)
+	assert.Equal(t, "plugin "+filepath.Join(plugins, PluginExecutablePrefix+"a"), duplicateErr.RegisteredBy)
+
+	// Loading the same plugin again is not a duplicate
+	again := t.TempDir()
+	writePlugin(t, again, "topics", "Test::Plugin::Topic")
+	require.NoError(t, LoadPlugins(ctx, []string{again}))
+	require.NoError(t, LoadPlugins(ctx, []string{again}))
+}
+
+func TestPluginBaseEnvironment(t *testing.T) {
+	t.Parallel()
+
+	env := pluginBaseEnvironment([]string{
+		"PATH=/usr/bin",
+		"AWS_PROFILE=admin",
+		"AWS_ACCESS_KEY_ID=AKIAPARENT",
+		"AWS_WEB_IDENTITY_TOKEN_FILE=/var/run/token",
+		"AWS_CONFIG_FILE=/home/me/.aws/config",
+		"AWS_PROFILE_SUFFIX=kept",
+	})
+	assert.Equal(t, []string{"PATH=/usr/bin", "AWS_CONFIG_FILE=/home/me/.aws/config", "AWS_PROFILE_SUFFIX=kept"}, env)
+}
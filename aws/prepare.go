@@ -0,0 +1,329 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// PrepareStep is the outcome of a single preparation step run before a resource is deleted
+type PrepareStep struct {
+	Description string
+	Error       error
+}
+
+// Preparer readies a resource of a given type for deletion through Cloud Control, for example by emptying it or by
+// turning off its deletion protection. It returns every step it attempted, in order, stopping at the first failure.
+type Preparer func(ctx context.Context, config aws.Config, svc CloudControlAPI, typeName, identifier string) []PrepareStep
+
+var (
+	preparers = map[string]Preparer{
+		"AWS::S3::Bucket":                           emptyS3Bucket,
+		"AWS::ECR::Repository":                      deleteECRImages,
+		"AWS::RDS::DBInstance":                      disableBooleanProperty("DeletionProtection", true),
+		"AWS::RDS::DBCluster":                       disableBooleanProperty("DeletionProtection", true),
+		"AWS::EC2::Instance":                        disableBooleanProperty("DisableApiTermination", true),
+		"AWS::ElasticLoadBalancingV2::LoadBalancer": disableLoadBalancerDeletionProtection,
+		"AWS::Route53::HostedZone":                  deleteHostedZoneRecords,
+	}
+	preparersMutex sync.RWMutex
+)
+
+// RegisterPreparer sets the Preparer that is run before deleting resources of the given type, replacing any
+// existing one
+func RegisterPreparer(typeName string, preparer Preparer) {
+	preparersMutex.Lock()
+	defer preparersMutex.Unlock()
+	preparers[typeName] = preparer
+}
+
+// prepareResource runs the Preparer registered for the type, if any. The returned error is nil only if every step
+// succeeded.
+func prepareResource(ctx context.Context, config aws.Config, svc CloudControlAPI, typeName, identifier string) ([]PrepareStep, error) {
+	preparersMutex.RLock()
+	preparer, ok := preparers[typeName]
+	preparersMutex.RUnlock()
+	if !ok {
+		return nil, nil
+	}
+
+	logging.Logger.Debugf("Preparing resource type: %s with identifier: %s for deletion", typeName, identifier)
+	steps := preparer(ctx, config, svc, typeName, identifier)
+	for _, step := range steps {
+		if step.Error != nil {
+			return steps, PrepareFailedError{Step: step.Description, Underlying: step.Error}
+		}
+	}
+	return steps, nil
+}
+
+// patchOperation is a single RFC 6902 JSON Patch operation, as accepted by Cloud Control UpdateResource
+type patchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+// getResourceProperties returns the current property model of a resource
+func getResourceProperties(ctx context.Context, svc CloudControlAPI, typeName, identifier string) (map[string]interface{}, error) {
+	output, err := svc.GetResource(ctx, &cloudcontrol.GetResourceInput{
+		TypeName:   aws.String(typeName),
+		Identifier: aws.String(identifier),
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	properties := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(aws.ToString(output.ResourceDescription.Properties)), &properties); err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return properties, nil
+}
+
+// updateResource applies a JSON Patch to a resource through Cloud Control and waits for the update to complete
+func updateResource(ctx context.Context, svc CloudControlAPI, typeName, identifier string, patch []patchOperation) error {
+	patchDocument, err := json.Marshal(patch)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	output, err := svc.UpdateResource(ctx, &cloudcontrol.UpdateResourceInput{
+		TypeName:      aws.String(typeName),
+		Identifier:    aws.String(identifier),
+		PatchDocument: aws.String(string(patchDocument)),
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	waiter := cloudcontrol.NewResourceRequestSuccessWaiter(svc, func(o *cloudcontrol.ResourceRequestSuccessWaiterOptions) {
+		o.Retryable = RetryGetResourceRequestStatus(nil)
+	})
+	waitParams := &cloudcontrol.GetResourceRequestStatusInput{
+		RequestToken: output.ProgressEvent.RequestToken,
+	}
+	return errors.WithStackTrace(waiter.Wait(ctx, waitParams, 10*time.Minute))
+}
+
+// disableBooleanProperty returns a Preparer that sets a top level boolean property, such as DeletionProtection, to
+// false if it is currently set to enabledValue
+func disableBooleanProperty(propertyName string, enabledValue bool) Preparer {
+	return func(ctx context.Context, config aws.Config, svc CloudControlAPI, typeName, identifier string) []PrepareStep {
+		properties, err := getResourceProperties(ctx, svc, typeName, identifier)
+		if err != nil {
+			return []PrepareStep{{Description: fmt.Sprintf("Read %s", propertyName), Error: err}}
+		}
+
+		if value, ok := properties[propertyName].(bool); !ok || value != enabledValue {
+			return nil
+		}
+
+		err = updateResource(ctx, svc, typeName, identifier, []patchOperation{
+			{Op: "replace", Path: "/" + propertyName, Value: !enabledValue},
+		})
+		return []PrepareStep{{Description: fmt.Sprintf("Set %s to %t", propertyName, !enabledValue), Error: err}}
+	}
+}
+
+// disableLoadBalancerDeletionProtection turns off the deletion_protection.enabled attribute of an ELBv2 load balancer
+func disableLoadBalancerDeletionProtection(ctx context.Context, config aws.Config, svc CloudControlAPI, typeName, identifier string) []PrepareStep {
+	const attributeKey = "deletion_protection.enabled"
+	description := "Disable deletion protection"
+
+	properties, err := getResourceProperties(ctx, svc, typeName, identifier)
+	if err != nil {
+		return []PrepareStep{{Description: description, Error: err}}
+	}
+
+	attributes, _ := properties["LoadBalancerAttributes"].([]interface{})
+	for i, attribute := range attributes {
+		attributeMap, _ := attribute.(map[string]interface{})
+		if attributeMap["Key"] != attributeKey {
+			continue
+		}
+		if attributeMap["Value"] != "true" {
+			return nil
+		}
+
+		err = updateResource(ctx, svc, typeName, identifier, []patchOperation{
+			{Op: "replace", Path: fmt.Sprintf("/LoadBalancerAttributes/%d/Value", i), Value: "false"},
+		})
+		return []PrepareStep{{Description: description, Error: err}}
+	}
+
+	return nil
+}
+
+// emptyS3Bucket deletes every object version and delete marker in a bucket, as non-empty buckets can't be deleted
+func emptyS3Bucket(ctx context.Context, config aws.Config, svc CloudControlAPI, typeName, identifier string) []PrepareStep {
+	description := "Empty bucket"
+
+	services, err := clientsFrom(ctx).Services(config)
+	if err != nil {
+		return []PrepareStep{{Description: description, Error: err}}
+	}
+
+	// Objects can only be deleted in the region of the bucket
+	location, err := services.S3.GetBucketLocationWithContext(ctx, &s3.GetBucketLocationInput{Bucket: awsgo.String(identifier)})
+	if err != nil {
+		return []PrepareStep{{Description: description, Error: errors.WithStackTrace(err)}}
+	}
+	bucketConfig := config.Copy()
+	bucketConfig.Region = s3.NormalizeBucketLocation(awsgo.StringValue(location.LocationConstraint))
+	bucketServices, err := clientsFrom(ctx).Services(bucketConfig)
+	if err != nil {
+		return []PrepareStep{{Description: description, Error: err}}
+	}
+	s3Svc := bucketServices.S3
+
+	deleted := 0
+	var deleteErr error
+	err = s3Svc.ListObjectVersionsPagesWithContext(ctx, &s3.ListObjectVersionsInput{Bucket: awsgo.String(identifier)}, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+		objects := []*s3.ObjectIdentifier{}
+		for _, version := range page.Versions {
+			objects = append(objects, &s3.ObjectIdentifier{Key: version.Key, VersionId: version.VersionId})
+		}
+		for _, marker := range page.DeleteMarkers {
+			objects = append(objects, &s3.ObjectIdentifier{Key: marker.Key, VersionId: marker.VersionId})
+		}
+		if len(objects) == 0 {
+			return true
+		}
+
+		output, err := s3Svc.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: awsgo.String(identifier),
+			Delete: &s3.Delete{Objects: objects, Quiet: awsgo.Bool(true)},
+		})
+		if err != nil {
+			deleteErr = err
+			return false
+		}
+		if len(output.Errors) > 0 {
+			deleteErr = fmt.Errorf("could not delete %d objects, first error: %s", len(output.Errors), awsgo.StringValue(output.Errors[0].Message))
+			return false
+		}
+
+		deleted += len(objects)
+		return true
+	})
+	if err == nil {
+		err = deleteErr
+	}
+
+	return []PrepareStep{{Description: fmt.Sprintf("%s: deleted %d object versions", description, deleted), Error: errors.WithStackTrace(err)}}
+}
+
+// deleteECRImages deletes every image in a repository, as repositories that contain images can't be deleted
+func deleteECRImages(ctx context.Context, config aws.Config, svc CloudControlAPI, typeName, identifier string) []PrepareStep {
+	description := "Delete images"
+
+	services, err := clientsFrom(ctx).Services(config)
+	if err != nil {
+		return []PrepareStep{{Description: description, Error: err}}
+	}
+	ecrSvc := services.ECR
+
+	deleted := 0
+	var deleteErr error
+	err = ecrSvc.ListImagesPagesWithContext(ctx, &ecr.ListImagesInput{RepositoryName: awsgo.String(identifier)}, func(page *ecr.ListImagesOutput, lastPage bool) bool {
+		// ListImages pages hold at most 100 images, which is also the BatchDeleteImage limit
+		if len(page.ImageIds) == 0 {
+			return true
+		}
+
+		output, err := ecrSvc.BatchDeleteImageWithContext(ctx, &ecr.BatchDeleteImageInput{
+			RepositoryName: awsgo.String(identifier),
+			ImageIds:       page.ImageIds,
+		})
+		if err != nil {
+			deleteErr = err
+			return false
+		}
+		if len(output.Failures) > 0 {
+			deleteErr = fmt.Errorf("could not delete %d images, first error: %s", len(output.Failures), awsgo.StringValue(output.Failures[0].FailureReason))
+			return false
+		}
+
+		deleted += len(output.ImageIds)
+		return true
+	})
+	if err == nil {
+		err = deleteErr
+	}
+
+	return []PrepareStep{{Description: fmt.Sprintf("%s: deleted %d images", description, deleted), Error: errors.WithStackTrace(err)}}
+}
+
+// deleteHostedZoneRecords deletes every record set of a hosted zone except the SOA and NS records at its apex, as
+// hosted zones that contain other records can't be deleted
+func deleteHostedZoneRecords(ctx context.Context, config aws.Config, svc CloudControlAPI, typeName, identifier string) []PrepareStep {
+	description := "Delete record sets"
+
+	services, err := clientsFrom(ctx).Services(config)
+	if err != nil {
+		return []PrepareStep{{Description: description, Error: err}}
+	}
+	route53Svc := services.Route53
+
+	zone, err := route53Svc.GetHostedZoneWithContext(ctx, &route53.GetHostedZoneInput{Id: awsgo.String(identifier)})
+	if err != nil {
+		return []PrepareStep{{Description: description, Error: errors.WithStackTrace(err)}}
+	}
+	zoneName := awsgo.StringValue(zone.HostedZone.Name)
+
+	changes := []*route53.Change{}
+	err = route53Svc.ListResourceRecordSetsPagesWithContext(ctx, &route53.ListResourceRecordSetsInput{HostedZoneId: awsgo.String(identifier)}, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		for _, recordSet := range page.ResourceRecordSets {
+			recordType := awsgo.StringValue(recordSet.Type)
+			if awsgo.StringValue(recordSet.Name) == zoneName && (recordType == route53.RRTypeSoa || recordType == route53.RRTypeNs) {
+				continue
+			}
+			changes = append(changes, &route53.Change{
+				Action:            awsgo.String(route53.ChangeActionDelete),
+				ResourceRecordSet: recordSet,
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return []PrepareStep{{Description: description, Error: errors.WithStackTrace(err)}}
+	}
+
+	// A change batch can hold at most 1000 changes
+	for _, batch := range splitChanges(changes, 1000) {
+		_, err := route53Svc.ChangeResourceRecordSetsWithContext(ctx, &route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: awsgo.String(identifier),
+			ChangeBatch:  &route53.ChangeBatch{Changes: batch},
+		})
+		if err != nil {
+			return []PrepareStep{{Description: description, Error: errors.WithStackTrace(err)}}
+		}
+	}
+
+	return []PrepareStep{{Description: fmt.Sprintf("%s: deleted %d record sets in %s", description, len(changes), strings.TrimSuffix(zoneName, "."))}}
+}
+
+func splitChanges(changes []*route53.Change, limit int) [][]*route53.Change {
+	batches := [][]*route53.Change{}
+	for len(changes) > limit {
+		batches = append(batches, changes[:limit])
+		changes = changes[limit:]
+	}
+	if len(changes) > 0 {
+		batches = append(batches, changes)
+	}
+	return batches
+}
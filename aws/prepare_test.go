@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrepareResourceRunsRegisteredPreparer(t *testing.T) {
+	t.Parallel()
+
+	typeName := "Test::Prepare::Succeeds"
+	RegisterPreparer(typeName, func(ctx context.Context, config aws.Config, svc CloudControlAPI, typeName, identifier string) []PrepareStep {
+		return []PrepareStep{{Description: "Unlock " + identifier}}
+	})
+
+	steps, err := prepareResource(context.Background(), aws.Config{}, nil, typeName, "my-resource")
+	require.NoError(t, err)
+	assert.Equal(t, []PrepareStep{{Description: "Unlock my-resource"}}, steps)
+}
+
+func TestPrepareResourceReportsFailedStep(t *testing.T) {
+	t.Parallel()
+
+	typeName := "Test::Prepare::Fails"
+	RegisterPreparer(typeName, func(ctx context.Context, config aws.Config, svc CloudControlAPI, typeName, identifier string) []PrepareStep {
+		return []PrepareStep{
+			{Description: "Empty"},
+			{Description: "Unlock", Error: errors.New("AccessDenied")},
+		}
+	})
+
+	steps, err := prepareResource(context.Background(), aws.Config{}, nil, typeName, "my-resource")
+	require.Error(t, err)
+	assert.Len(t, steps, 2)
+
+	var prepareErr PrepareFailedError
+	require.ErrorAs(t, err, &prepareErr)
+	assert.Equal(t, "Unlock", prepareErr.Step)
+}
+
+func TestPrepareResourceWithoutPreparer(t *testing.T) {
+	t.Parallel()
+
+	steps, err := prepareResource(context.Background(), aws.Config{}, nil, "AWS::Logs::LogGroup", "my-log-group")
+	require.NoError(t, err)
+	assert.Empty(t, steps)
+}
+
+func TestPatchOperationKeepsFalseValues(t *testing.T) {
+	t.Parallel()
+
+	patch, err := json.Marshal([]patchOperation{{Op: "replace", Path: "/DeletionProtection", Value: false}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"op": "replace", "path": "/DeletionProtection", "value": false}]`, string(patch))
+}
+
+func TestSplitChanges(t *testing.T) {
+	t.Parallel()
+
+	changes := make([]*route53.Change, 5)
+	assert.Len(t, splitChanges(changes, 2), 3)
+	assert.Len(t, splitChanges(changes, 5), 1)
+	assert.Empty(t, splitChanges(nil, 5))
+}
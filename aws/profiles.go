@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	v2aws "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gruntwork-io/cloud-nuke/externalcreds"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// ProfileAccount is an account reached with one or more profiles of the shared AWS config
+type ProfileAccount struct {
+	Identity *AccountIdentity
+	// Profiles are the profiles whose credentials belong to the account, sorted. The first one is used.
+	Profiles []string
+
+	credentials v2aws.CredentialsProvider
+}
+
+// OrgAccount returns the account, named after its profiles, with the credentials of its first profile, so that it
+// can be run against like the member accounts of an organization
+func (account ProfileAccount) OrgAccount() OrgAccount {
+	return OrgAccount{
+		ID:          account.Identity.AccountID,
+		Name:        strings.Join(account.Profiles, ", "),
+		Credentials: account.credentials,
+	}
+}
+
+// resolvedProfile is a profile whose credentials have been resolved to an account
+type resolvedProfile struct {
+	name        string
+	identity    *AccountIdentity
+	credentials v2aws.CredentialsProvider
+}
+
+// ListProfileAccounts resolves the account of every profile of the shared AWS config that matches glob. Profiles that
+// belong to the same account are merged, so that each account is only run against once. Profiles whose credentials
+// can't be resolved, such as expired SSO sessions, are skipped with a warning.
+func ListProfileAccounts(ctx context.Context, glob string) ([]ProfileAccount, error) {
+	profiles, err := externalcreds.ListProfiles(glob)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	if len(profiles) == 0 {
+		return nil, errors.WithStackTrace(NoMatchingProfilesError{Glob: glob})
+	}
+
+	resolved := []resolvedProfile{}
+	for _, profile := range profiles {
+		credentials, err := externalcreds.Resolve(ctx, externalcreds.Options{Profile: profile})
+		if err != nil {
+			logging.Logger.Warnf("Skipping profile %s, whose credentials could not be resolved: %s", profile, err)
+			continue
+		}
+
+		identity, err := GetAccountIdentity(externalcreds.WithCredentials(ctx, credentials))
+		if err != nil {
+			logging.Logger.Warnf("Skipping profile %s, whose account could not be looked up: %s", profile, errors.Unwrap(err))
+			continue
+		}
+		logging.Logger.Debugf("Profile %s is account %s", profile, identity)
+
+		resolved = append(resolved, resolvedProfile{name: profile, identity: identity, credentials: credentials})
+	}
+
+	return groupProfilesByAccount(resolved), nil
+}
+
+// groupProfilesByAccount merges the profiles of the same account, keeping the credentials of the first one, and
+// returns the accounts sorted by ID
+func groupProfilesByAccount(resolved []resolvedProfile) []ProfileAccount {
+	byAccount := map[string]*ProfileAccount{}
+	for _, profile := range resolved {
+		accountID := profile.identity.AccountID
+		if account, ok := byAccount[accountID]; ok {
+			logging.Logger.Infof("Profile %s is the same account %s as profile %s, so it will only be run against once", profile.name, accountID, account.Profiles[0])
+			account.Profiles = append(account.Profiles, profile.name)
+			continue
+		}
+		byAccount[accountID] = &ProfileAccount{
+			Identity:    profile.identity,
+			Profiles:    []string{profile.name},
+			credentials: profile.credentials,
+		}
+	}
+
+	accounts := []ProfileAccount{}
+	for _, account := range byAccount {
+		accounts = append(accounts, *account)
+	}
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].Identity.AccountID < accounts[j].Identity.AccountID })
+	return accounts
+}
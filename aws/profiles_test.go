@@ -0,0 +1,44 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/gruntwork-io/cloud-nuke/externalcreds"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupProfilesByAccount(t *testing.T) {
+	t.Parallel()
+
+	sandboxA := credentials.NewStaticCredentialsProvider("A", "secret", "")
+	sandboxC := credentials.NewStaticCredentialsProvider("C", "secret", "")
+	resolved := []resolvedProfile{
+		{name: "sandbox-a", identity: &AccountIdentity{AccountID: "222222222222"}, credentials: sandboxA},
+		{name: "sandbox-b", identity: &AccountIdentity{AccountID: "222222222222"}, credentials: credentials.NewStaticCredentialsProvider("B", "secret", "")},
+		{name: "sandbox-c", identity: &AccountIdentity{AccountID: "111111111111"}, credentials: sandboxC},
+	}
+
+	accounts := groupProfilesByAccount(resolved)
+	require.Len(t, accounts, 2)
+
+	assert.Equal(t, OrgAccount{ID: "111111111111", Name: "sandbox-c", Credentials: sandboxC}, accounts[0].OrgAccount())
+	assert.Equal(t, []string{"sandbox-a", "sandbox-b"}, accounts[1].Profiles)
+	assert.Equal(t, OrgAccount{ID: "222222222222", Name: "sandbox-a, sandbox-b", Credentials: sandboxA}, accounts[1].OrgAccount())
+}
+
+func TestWithOrgAccountCredentialsUsesAccountCredentials(t *testing.T) {
+	t.Parallel()
+
+	account := OrgAccount{ID: "111111111111", Credentials: credentials.NewStaticCredentialsProvider("PROFILE", "secret", "")}
+	ctx, err := WithOrgAccountCredentials(context.Background(), account, OrgOptions{RoleName: DefaultOrgRoleName})
+	require.NoError(t, err)
+
+	config, err := externalcreds.Get(ctx, "us-east-1")
+	require.NoError(t, err)
+	creds, err := config.Credentials.Retrieve(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "PROFILE", creds.AccessKeyID)
+}
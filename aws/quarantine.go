@@ -0,0 +1,340 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cloudformation_types "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/pterm/pterm"
+)
+
+// QuarantineTagKey marks a resource as scheduled for deletion. Its value is the time, in RFC 3339 format, after
+// which a run in quarantine mode deletes the resource.
+const QuarantineTagKey = "cloud-nuke-scheduled-for-deletion"
+
+// QuarantineAction is what a run in quarantine mode does with a resource
+type QuarantineAction string
+
+const (
+	// QuarantineMark - the resource is not marked yet, so it is tagged with the time it will be deleted at
+	QuarantineMark QuarantineAction = "mark"
+	// QuarantineWait - the resource is marked, but its grace period has not expired yet
+	QuarantineWait QuarantineAction = "wait"
+	// QuarantineDelete - the grace period of the resource has expired, so it is deleted
+	QuarantineDelete QuarantineAction = "delete"
+	// QuarantineKeep - the resource is excluded with the cloud-nuke-excluded tag, or can't be marked
+	QuarantineKeep QuarantineAction = "keep"
+)
+
+// QuarantinePlan is the action that quarantine mode takes for each scanned resource
+type QuarantinePlan struct {
+	Entries []*QuarantineEntry
+}
+
+// QuarantineEntry is the quarantine action for a single resource
+type QuarantineEntry struct {
+	Region      string
+	TypeName    string
+	Identifier  string
+	Action      QuarantineAction
+	ScheduledAt time.Time
+	Note        string
+	Error       error
+
+	properties map[string]interface{}
+}
+
+// stopper stops or disables a resource that was marked for deletion, returning a description of what it did
+type stopper func(ctx context.Context, config aws.Config, svc CloudControlAPI, identifier string) (string, error)
+
+var stoppers = map[string]stopper{
+	"AWS::EC2::Instance":                 stopEC2Instance,
+	"AWS::RDS::DBInstance":               stopDBInstance,
+	"AWS::RDS::DBCluster":                stopDBCluster,
+	"AWS::Lambda::Function":              patchStopper("AWS::Lambda::Function", "Set reserved concurrency to 0", patchOperation{Op: "add", Path: "/ReservedConcurrentExecutions", Value: 0}),
+	"AWS::Events::Rule":                  patchStopper("AWS::Events::Rule", "Disabled the rule", patchOperation{Op: "add", Path: "/State", Value: "DISABLED"}),
+	"AWS::ECS::Service":                  patchStopper("AWS::ECS::Service", "Set desired count to 0", patchOperation{Op: "add", Path: "/DesiredCount", Value: 0}),
+	"AWS::AutoScaling::AutoScalingGroup": patchStopper("AWS::AutoScaling::AutoScalingGroup", "Scaled to 0 instances", patchOperation{Op: "replace", Path: "/MinSize", Value: "0"}, patchOperation{Op: "replace", Path: "/MaxSize", Value: "0"}, patchOperation{Op: "add", Path: "/DesiredCapacity", Value: "0"}),
+}
+
+// PlanQuarantine reads the tags of every resource in the account to decide whether it must be marked for deletion,
+// is still in its grace period, or is due for deletion
+func PlanQuarantine(ctx context.Context, account *AwsAccountResources, now time.Time) (*QuarantinePlan, error) {
+	plan := &QuarantinePlan{}
+
+	for region, resourcesInRegion := range account.Resources {
+		config, err := newConfig(ctx, sessionRegion(ctx, region))
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		svc := clientsFrom(ctx).CloudControl(config)
+
+		for _, resources := range resourcesInRegion.Resources {
+			for _, identifier := range resources.ResourceIdentifiers() {
+				if ctx.Err() != nil {
+					return nil, errors.WithStackTrace(ctx.Err())
+				}
+
+				entry := &QuarantineEntry{Region: region, TypeName: resources.ResourceName(), Identifier: identifier}
+				plan.Entries = append(plan.Entries, entry)
+
+				if _, ok := resourceHandlerFor(entry.TypeName); ok {
+					entry.Action = QuarantineKeep
+					entry.Note = "only Cloud Control resources can be marked"
+					continue
+				}
+
+				properties, err := getResourceProperties(ctx, svc, entry.TypeName, identifier)
+				if err != nil {
+					entry.Action = QuarantineKeep
+					entry.Note = "could not read the tags of the resource"
+					entry.Error = err
+					continue
+				}
+				entry.properties = properties
+
+				model, err := json.Marshal(properties)
+				if err != nil {
+					return nil, errors.WithStackTrace(err)
+				}
+				entry.Action, entry.ScheduledAt, entry.Note = classifyQuarantine(extractTags(model), now)
+			}
+		}
+	}
+
+	sort.SliceStable(plan.Entries, func(i, j int) bool {
+		return plan.Entries[i].Region < plan.Entries[j].Region
+	})
+	return plan, nil
+}
+
+// classifyQuarantine decides the quarantine action from the tags of a resource
+func classifyQuarantine(tags map[string]string, now time.Time) (QuarantineAction, time.Time, string) {
+	if _, ok := tags[AwsResourceExclusionTagKey]; ok {
+		return QuarantineKeep, time.Time{}, fmt.Sprintf("tagged %s", AwsResourceExclusionTagKey)
+	}
+
+	marker, ok := tags[QuarantineTagKey]
+	if !ok {
+		return QuarantineMark, time.Time{}, ""
+	}
+
+	scheduledAt, err := time.Parse(time.RFC3339, marker)
+	if err != nil {
+		return QuarantineMark, time.Time{}, fmt.Sprintf("invalid %s tag %q", QuarantineTagKey, marker)
+	}
+	if now.Before(scheduledAt) {
+		return QuarantineWait, scheduledAt, ""
+	}
+	return QuarantineDelete, scheduledAt, ""
+}
+
+// EntriesWithAction returns the entries with the given action
+func (plan *QuarantinePlan) EntriesWithAction(action QuarantineAction) []*QuarantineEntry {
+	entries := []*QuarantineEntry{}
+	for _, entry := range plan.Entries {
+		if entry.Action == action {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// Due returns the resources whose grace period has expired, which are the only ones nuked in quarantine mode
+func (plan *QuarantinePlan) Due() *AwsAccountResources {
+	account := &AwsAccountResources{
+		Resources: make(map[string]AwsRegionResource),
+	}
+	for _, entry := range plan.EntriesWithAction(QuarantineDelete) {
+		account.add(entry.Region, entry.TypeName, entry.Identifier)
+	}
+	return account
+}
+
+// MarkForDeletion tags every resource the plan marks with the time its grace period ends, and, if stop is set, stops
+// or disables the resources that support it. Returns a QuarantineFailedError if any resource could not be marked.
+func MarkForDeletion(ctx context.Context, plan *QuarantinePlan, gracePeriod time.Duration, stop bool, now time.Time) error {
+	scheduledAt := now.Add(gracePeriod).UTC().Truncate(time.Second)
+	configs := map[string]aws.Config{}
+	failed := 0
+
+	for _, entry := range plan.EntriesWithAction(QuarantineMark) {
+		if ctx.Err() != nil {
+			return errors.WithStackTrace(ctx.Err())
+		}
+
+		config, ok := configs[entry.Region]
+		if !ok {
+			var err error
+			config, err = newConfig(ctx, sessionRegion(ctx, entry.Region))
+			if err != nil {
+				return errors.WithStackTrace(err)
+			}
+			configs[entry.Region] = config
+		}
+		svc := clientsFrom(ctx).CloudControl(config)
+
+		logging.Logger.Infof("Marking resource type: %s with identifier: %s for deletion at %s", entry.TypeName, entry.Identifier, scheduledAt.Format(time.RFC3339))
+		entry.ScheduledAt = scheduledAt
+
+		patch, err := quarantineTagPatch(ctx, clientsFrom(ctx).CloudFormation(config), entry.TypeName, entry.properties, scheduledAt)
+		if err == nil {
+			err = updateResource(ctx, svc, entry.TypeName, entry.Identifier, patch)
+		}
+		if err != nil {
+			entry.Error = err
+			failed++
+			continue
+		}
+
+		if stopResource, ok := stoppers[entry.TypeName]; ok && stop {
+			description, err := stopResource(ctx, config, svc, entry.Identifier)
+			entry.Note = description
+			if err != nil {
+				entry.Error = err
+				failed++
+			}
+		}
+	}
+
+	if failed > 0 {
+		return QuarantineFailedError{Count: failed}
+	}
+	return nil
+}
+
+// quarantineTagPatch returns the JSON Patch that sets the quarantine tag, whether the type models its tags as a list
+// of Key/Value pairs or as a map. Resources without tags yet are patched in the format of the type schema.
+func quarantineTagPatch(ctx context.Context, cfnSvc CloudFormationAPI, typeName string, properties map[string]interface{}, scheduledAt time.Time) ([]patchOperation, error) {
+	value := scheduledAt.Format(time.RFC3339)
+	tag := map[string]string{"Key": QuarantineTagKey, "Value": value}
+
+	switch tags := properties["Tags"].(type) {
+	case []interface{}:
+		for i, existing := range tags {
+			if existingTag, ok := existing.(map[string]interface{}); ok && existingTag["Key"] == QuarantineTagKey {
+				return []patchOperation{{Op: "replace", Path: fmt.Sprintf("/Tags/%d/Value", i), Value: value}}, nil
+			}
+		}
+		return []patchOperation{{Op: "add", Path: "/Tags/-", Value: tag}}, nil
+	case map[string]interface{}:
+		return []patchOperation{{Op: "add", Path: "/Tags/" + escapeJSONPointer(QuarantineTagKey), Value: value}}, nil
+	}
+
+	output, err := cfnSvc.DescribeType(ctx, &cloudformation.DescribeTypeInput{
+		Type:     cloudformation_types.RegistryTypeResource,
+		TypeName: aws.String(typeName),
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	schema := struct {
+		Properties map[string]struct {
+			Type interface{} `json:"type"`
+		} `json:"properties"`
+	}{}
+	if err := json.Unmarshal([]byte(aws.ToString(output.Schema)), &schema); err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	tagsProperty, ok := schema.Properties["Tags"]
+	if !ok {
+		return nil, errors.WithStackTrace(TagsNotSupportedError{TypeName: typeName})
+	}
+	if tagsProperty.Type == "object" {
+		return []patchOperation{{Op: "add", Path: "/Tags", Value: map[string]string{QuarantineTagKey: value}}}, nil
+	}
+	return []patchOperation{{Op: "add", Path: "/Tags", Value: []map[string]string{tag}}}, nil
+}
+
+// escapeJSONPointer escapes a key for use in a JSON Pointer, as defined by RFC 6901
+func escapeJSONPointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+// patchStopper returns a stopper that applies a JSON Patch through Cloud Control
+func patchStopper(typeName, description string, patch ...patchOperation) stopper {
+	return func(ctx context.Context, config aws.Config, svc CloudControlAPI, identifier string) (string, error) {
+		return description, updateResource(ctx, svc, typeName, identifier, patch)
+	}
+}
+
+func stopEC2Instance(ctx context.Context, config aws.Config, svc CloudControlAPI, identifier string) (string, error) {
+	ec2Svc, err := newEC2Client(ctx, config)
+	if err != nil {
+		return "", err
+	}
+	_, err = ec2Svc.StopInstancesWithContext(ctx, &ec2.StopInstancesInput{InstanceIds: awsgo.StringSlice([]string{identifier})})
+	return "Stopped the instance", errors.WithStackTrace(err)
+}
+
+func stopDBInstance(ctx context.Context, config aws.Config, svc CloudControlAPI, identifier string) (string, error) {
+	services, err := clientsFrom(ctx).Services(config)
+	if err != nil {
+		return "", err
+	}
+	_, err = services.RDS.StopDBInstanceWithContext(ctx, &rds.StopDBInstanceInput{DBInstanceIdentifier: awsgo.String(identifier)})
+	return "Stopped the database", errors.WithStackTrace(err)
+}
+
+func stopDBCluster(ctx context.Context, config aws.Config, svc CloudControlAPI, identifier string) (string, error) {
+	services, err := clientsFrom(ctx).Services(config)
+	if err != nil {
+		return "", err
+	}
+	_, err = services.RDS.StopDBClusterWithContext(ctx, &rds.StopDBClusterInput{DBClusterIdentifier: awsgo.String(identifier)})
+	return "Stopped the cluster", errors.WithStackTrace(err)
+}
+
+// RenderQuarantinePlan prints the quarantine action of every resource, grouped by region
+func RenderQuarantinePlan(plan *QuarantinePlan) {
+	byRegion := map[string][]*QuarantineEntry{}
+	regions := []string{}
+	for _, entry := range plan.Entries {
+		if _, ok := byRegion[entry.Region]; !ok {
+			regions = append(regions, entry.Region)
+		}
+		byRegion[entry.Region] = append(byRegion[entry.Region], entry)
+	}
+
+	for _, region := range regions {
+		tableData := pterm.TableData{{"Resource", "Action", "ScheduledAt", "Note", "Error"}}
+		for _, entry := range byRegion[region] {
+			scheduledAt := ""
+			if !entry.ScheduledAt.IsZero() {
+				scheduledAt = entry.ScheduledAt.Format(time.RFC3339)
+			}
+			errorMessage := ""
+			if entry.Error != nil {
+				errorMessage = truncateText(entry.Error.Error(), 60)
+			}
+			tableData = append(tableData, []string{
+				colorTypeAndIdentifier(entry.TypeName, entry.Identifier),
+				string(entry.Action),
+				scheduledAt,
+				entry.Note,
+				errorMessage,
+			})
+		}
+
+		pterm.Println()
+		renderSection(fmt.Sprintf("Quarantine - Region: %s", region))
+		pterm.DefaultTable.
+			WithHasHeader().
+			WithData(tableData).
+			Render()
+		pterm.Println()
+	}
+}
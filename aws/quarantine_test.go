@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyQuarantine(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	scheduledAt := now.Add(time.Hour)
+
+	tests := []struct {
+		name   string
+		tags   map[string]string
+		action QuarantineAction
+	}{
+		{"NotMarked", nil, QuarantineMark},
+		{"InvalidMarker", map[string]string{QuarantineTagKey: "tomorrow"}, QuarantineMark},
+		{"GracePeriod", map[string]string{QuarantineTagKey: scheduledAt.Format(time.RFC3339)}, QuarantineWait},
+		{"Expired", map[string]string{QuarantineTagKey: now.Add(-time.Hour).Format(time.RFC3339)}, QuarantineDelete},
+		{"Excluded", map[string]string{AwsResourceExclusionTagKey: "true", QuarantineTagKey: now.Format(time.RFC3339)}, QuarantineKeep},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			action, _, _ := classifyQuarantine(tt.tags, now)
+			assert.Equal(t, tt.action, action)
+		})
+	}
+}
+
+func TestQuarantineTagPatch(t *testing.T) {
+	t.Parallel()
+
+	scheduledAt := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+	value := "2026-10-20T12:00:00Z"
+
+	tests := []struct {
+		name       string
+		properties map[string]interface{}
+		expected   []patchOperation
+	}{
+		{
+			"TagList",
+			map[string]interface{}{"Tags": []interface{}{map[string]interface{}{"Key": "team", "Value": "platform"}}},
+			[]patchOperation{{Op: "add", Path: "/Tags/-", Value: map[string]string{"Key": QuarantineTagKey, "Value": value}}},
+		},
+		{
+			"TagListAlreadyMarked",
+			map[string]interface{}{"Tags": []interface{}{
+				map[string]interface{}{"Key": "team", "Value": "platform"},
+				map[string]interface{}{"Key": QuarantineTagKey, "Value": "tomorrow"},
+			}},
+			[]patchOperation{{Op: "replace", Path: "/Tags/1/Value", Value: value}},
+		},
+		{
+			"TagMap",
+			map[string]interface{}{"Tags": map[string]interface{}{"team": "platform"}},
+			[]patchOperation{{Op: "add", Path: "/Tags/" + QuarantineTagKey, Value: value}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			patch, err := quarantineTagPatch(context.Background(), nil, "AWS::Logs::LogGroup", tt.properties, scheduledAt)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, patch)
+		})
+	}
+}
+
+func TestQuarantinePlanDue(t *testing.T) {
+	t.Parallel()
+
+	plan := &QuarantinePlan{Entries: []*QuarantineEntry{
+		{Region: "us-east-1", TypeName: "AWS::Logs::LogGroup", Identifier: "expired", Action: QuarantineDelete},
+		{Region: "us-east-1", TypeName: "AWS::Logs::LogGroup", Identifier: "waiting", Action: QuarantineWait},
+		{Region: "eu-west-1", TypeName: "AWS::SNS::Topic", Identifier: "new", Action: QuarantineMark},
+	}}
+
+	due := plan.Due()
+	require.Len(t, due.Resources, 1)
+	require.Len(t, due.Resources["us-east-1"].Resources, 1)
+	assert.Equal(t, []string{"expired"}, due.Resources["us-east-1"].Resources[0].ResourceIdentifiers())
+	assert.Len(t, plan.EntriesWithAction(QuarantineMark), 1)
+}
+
+func TestEscapeJSONPointer(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "a~1b~0c", escapeJSONPointer("a/b~c"))
+}
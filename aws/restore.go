@@ -0,0 +1,429 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/go-commons/collections"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/pterm/pterm"
+)
+
+// dataLossCaveats lists what a Cloud Control model doesn't capture for resource types that hold data, so restoring
+// them only recreates an empty resource
+var dataLossCaveats = map[string]string{
+	"AWS::S3::Bucket":                      "objects in the bucket are not restored",
+	"AWS::DynamoDB::Table":                 "items in the table are not restored",
+	"AWS::ECR::Repository":                 "images in the repository are not restored",
+	"AWS::EFS::FileSystem":                 "files on the file system are not restored",
+	"AWS::EC2::Volume":                     "data on the volume is not restored",
+	"AWS::RDS::DBInstance":                 "data in the database is not restored",
+	"AWS::RDS::DBCluster":                  "data in the database is not restored",
+	"AWS::SecretsManager::Secret":          "the secret value is not restored",
+	"AWS::SSM::Parameter":                  "SecureString values are not restored",
+	"AWS::SQS::Queue":                      "messages in the queue are not restored",
+	"AWS::Kinesis::Stream":                 "records in the stream are not restored",
+	"AWS::Logs::LogGroup":                  "log events are not restored",
+	"AWS::KMS::Key":                        "data encrypted with the old key can't be decrypted with the new one",
+	"AWS::CertificateManager::Certificate": "certificates must be validated again",
+}
+
+// RestoreOptions selects the resources of a backup to restore. Empty lists select everything.
+type RestoreOptions struct {
+	Regions       []string
+	ResourceTypes []string
+	Identifiers   []string
+	// DryRun only reports what would be restored
+	DryRun bool
+	// MaxWait is how long to wait on each creation request
+	MaxWait time.Duration
+	// AllowAccountMismatch restores the backup even if it was taken in another account than the one of the current
+	// credentials, or in an account that it doesn't record
+	AllowAccountMismatch bool
+}
+
+// RestoreResult is the outcome of restoring a single resource
+type RestoreResult struct {
+	Resource      BackupResource
+	Status        string
+	NewIdentifier string
+	// Notes explain what could not be faithfully restored
+	Notes []string
+	Error error
+}
+
+// Restore statuses
+const (
+	RestoreStatusRestored = "RESTORED"
+	RestoreStatusPlanned  = "PLANNED"
+	RestoreStatusSkipped  = "SKIPPED"
+	RestoreStatusFailed   = "FAILED"
+)
+
+// typeSchema is the part of a CloudFormation resource schema that restoring needs
+type typeSchema struct {
+	ReadOnlyProperties  []string `json:"readOnlyProperties"`
+	WriteOnlyProperties []string `json:"writeOnlyProperties"`
+}
+
+// RestoreBackup recreates the selected resources of a backup through Cloud Control's CreateResource. Resources are
+// created in dependency order, and references to the identifiers of resources that were recreated are rewritten to
+// their new identifiers. Unless opts.AllowAccountMismatch is set, nothing is created if the backup was taken in another
+// account than the one of the current credentials. Returns a RestoreFailedError if any resource could not be created.
+func RestoreBackup(ctx context.Context, backup *Backup, opts RestoreOptions) ([]RestoreResult, error) {
+	if !opts.DryRun && !opts.AllowAccountMismatch {
+		output, _, err := getCallerIdentity(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkBackupAccount(backup.AccountID, awsgo.StringValue(output.Account)); err != nil {
+			return nil, err
+		}
+	}
+
+	// Each restore is a new attempt, so that restoring again after a failed or undone restore creates the resources
+	// again instead of Cloud Control returning the earlier requests
+	attemptID := util.UniqueID()
+	resources := orderByDependency(selectBackupResources(backup.Resources, opts))
+	// The identifiers of recreated resources, by region and old identifier
+	newIdentifiers := map[string]map[string]string{}
+	results := []RestoreResult{}
+	failed := 0
+
+	for _, resource := range resources {
+		if ctx.Err() != nil {
+			break
+		}
+
+		result := restoreResource(ctx, backup, attemptID, resource, newIdentifiers[resource.Region], opts)
+		if result.Status == RestoreStatusFailed {
+			failed++
+		}
+		if result.NewIdentifier != "" {
+			if newIdentifiers[resource.Region] == nil {
+				newIdentifiers[resource.Region] = map[string]string{}
+			}
+			newIdentifiers[resource.Region][resource.Identifier] = result.NewIdentifier
+		}
+		results = append(results, result)
+	}
+
+	RenderRestoreResults(results)
+
+	if ctx.Err() != nil {
+		return results, NukeInterruptedError{}
+	}
+	if failed > 0 {
+		return results, RestoreFailedError{Count: failed}
+	}
+	return results, nil
+}
+
+// checkBackupAccount returns a BackupAccountMismatchError unless the backup was taken in the given account
+func checkBackupAccount(backupAccountID, accountID string) error {
+	if backupAccountID == "" || backupAccountID != accountID {
+		return errors.WithStackTrace(BackupAccountMismatchError{BackupAccountID: backupAccountID, AccountID: accountID})
+	}
+	return nil
+}
+
+// isPluginType returns true if the type is handled by a plugin, either when the backup was taken or now
+func isPluginType(backup *Backup, typeName string) bool {
+	if backup.Schemas[typeName].Plugin != "" {
+		return true
+	}
+	handler, ok := resourceHandlerFor(typeName)
+	if !ok {
+		return false
+	}
+	_, ok = handler.(pluginHandler)
+	return ok
+}
+
+func restoreResource(ctx context.Context, backup *Backup, attemptID string, resource BackupResource, newIdentifiers map[string]string, opts RestoreOptions) RestoreResult {
+	result := RestoreResult{Resource: resource}
+
+	if resource.Error != "" {
+		result.Status = RestoreStatusSkipped
+		result.Notes = []string{"the resource was not backed up: " + resource.Error}
+		return result
+	}
+	if strings.HasPrefix(resource.TypeName, CustomResourceTypePrefix) {
+		result.Status = RestoreStatusSkipped
+		result.Notes = []string{"cloud-nuke can only restore resource types that Cloud Control can create"}
+		return result
+	}
+	if isPluginType(backup, resource.TypeName) {
+		// Cloud Control doesn't know the types of plugins, so creating them would only fail with an obscure error
+		result.Status = RestoreStatusFailed
+		result.Error = errors.WithStackTrace(UnrestorableResourceTypeError{TypeName: resource.TypeName})
+		return result
+	}
+
+	desiredState, notes, err := restorableModel(resource, backup.Schemas[resource.TypeName], newIdentifiers)
+	result.Notes = notes
+	if err != nil {
+		result.Status = RestoreStatusFailed
+		result.Error = err
+		return result
+	}
+
+	if opts.DryRun {
+		result.Status = RestoreStatusPlanned
+		return result
+	}
+
+	config, err := newConfig(ctx, sessionRegion(ctx, resource.Region))
+	if err != nil {
+		result.Status = RestoreStatusFailed
+		result.Error = errors.WithStackTrace(err)
+		return result
+	}
+
+	identifier, err := createResource(ctx, clientsFrom(ctx).CloudControl(config), attemptID, resource, desiredState, opts.MaxWait)
+	if err != nil {
+		result.Status = RestoreStatusFailed
+		result.Error = err
+		return result
+	}
+
+	result.Status = RestoreStatusRestored
+	result.NewIdentifier = identifier
+	return result
+}
+
+// createResource submits a CreateResource request and waits on it, returning the identifier of the new resource. The
+// client token is derived from the restore attempt, so a resubmitted request is not mistaken for a new one, while a
+// later restore of the same archive is.
+func createResource(ctx context.Context, svc CloudControlAPI, attemptID string, resource BackupResource, desiredState string, maxWait time.Duration) (string, error) {
+	logging.Logger.Infof("Restoring resource type: %s with identifier: %s", resource.TypeName, resource.Identifier)
+
+	output, err := svc.CreateResource(ctx, &cloudcontrol.CreateResourceInput{
+		TypeName:     aws.String(resource.TypeName),
+		DesiredState: aws.String(desiredState),
+		ClientToken:  aws.String(clientToken("restore-"+attemptID, resource.Region, resource.TypeName, resource.Identifier, 0)),
+	})
+	if err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+
+	statusInput := &cloudcontrol.GetResourceRequestStatusInput{RequestToken: output.ProgressEvent.RequestToken}
+	waiter := cloudcontrol.NewResourceRequestSuccessWaiter(svc, func(o *cloudcontrol.ResourceRequestSuccessWaiterOptions) {
+		o.Retryable = RetryGetResourceRequestStatus(nil)
+	})
+	statusOutput, err := waiter.WaitForOutput(ctx, statusInput, maxWait)
+	if err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+
+	progressEvent := statusOutput.ProgressEvent
+	if progressEvent.OperationStatus != types.OperationStatusSuccess {
+		return "", errors.WithStackTrace(fmt.Errorf("creation finished with status %s: %s", progressEvent.OperationStatus, aws.ToString(progressEvent.StatusMessage)))
+	}
+	return aws.ToString(progressEvent.Identifier), nil
+}
+
+// selectBackupResources returns the resources matched by the options, in archive order
+func selectBackupResources(resources []BackupResource, opts RestoreOptions) []BackupResource {
+	selected := []BackupResource{}
+	for _, resource := range resources {
+		if len(opts.Regions) > 0 && !collections.ListContainsElement(opts.Regions, resource.Region) {
+			continue
+		}
+		if len(opts.ResourceTypes) > 0 && !collections.ListContainsElement(opts.ResourceTypes, resource.TypeName) {
+			continue
+		}
+		if len(opts.Identifiers) > 0 && !collections.ListContainsElement(opts.Identifiers, resource.Identifier) {
+			continue
+		}
+		selected = append(selected, resource)
+	}
+	return selected
+}
+
+// restorableModel turns a backed up model into the desired state of a CreateResource request: read-only properties
+// are removed, and references to recreated resources are rewritten to their new identifiers. The notes list what the
+// new resource will be missing.
+func restorableModel(resource BackupResource, schema BackupSchema, newIdentifiers map[string]string) (string, []string, error) {
+	notes := []string{}
+	if caveat, ok := dataLossCaveats[resource.TypeName]; ok {
+		notes = append(notes, caveat)
+	}
+
+	var model interface{}
+	if err := json.Unmarshal(resource.Properties, &model); err != nil {
+		return "", notes, errors.WithStackTrace(err)
+	}
+
+	parsedSchema := typeSchema{}
+	if len(schema.Schema) == 0 {
+		notes = append(notes, "the type schema was not backed up, so read-only properties could not be removed")
+	} else if err := json.Unmarshal(schema.Schema, &parsedSchema); err != nil {
+		return "", notes, errors.WithStackTrace(err)
+	}
+
+	for _, pointer := range parsedSchema.ReadOnlyProperties {
+		removeProperty(model, schemaPointerParts(pointer))
+	}
+	if len(parsedSchema.WriteOnlyProperties) > 0 {
+		// Cloud Control never returns write-only properties, such as passwords, so they can't be in the backup
+		writeOnly := []string{}
+		for _, pointer := range parsedSchema.WriteOnlyProperties {
+			writeOnly = append(writeOnly, strings.TrimPrefix(pointer, "/properties/"))
+		}
+		notes = append(notes, "write-only properties were not backed up: "+strings.Join(writeOnly, ", "))
+	}
+
+	model = replaceIdentifiers(model, newIdentifiers)
+
+	desiredState, err := json.Marshal(model)
+	if err != nil {
+		return "", notes, errors.WithStackTrace(err)
+	}
+	return string(desiredState), notes, nil
+}
+
+// schemaPointerParts splits a schema property pointer such as /properties/Config/Arn into the path of the property in
+// a resource model
+func schemaPointerParts(pointer string) []string {
+	return strings.Split(strings.TrimPrefix(pointer, "/properties/"), "/")
+}
+
+// removeProperty deletes the property at path from a model. A "*" in the path matches every item of an array.
+func removeProperty(value interface{}, path []string) {
+	if len(path) == 0 {
+		return
+	}
+
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		if len(path) == 1 {
+			delete(typed, path[0])
+			return
+		}
+		removeProperty(typed[path[0]], path[1:])
+	case []interface{}:
+		if path[0] != "*" {
+			return
+		}
+		for _, item := range typed {
+			removeProperty(item, path[1:])
+		}
+	}
+}
+
+// replaceIdentifiers rewrites every string in the model that is the old identifier of a recreated resource
+func replaceIdentifiers(value interface{}, newIdentifiers map[string]string) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, item := range typed {
+			typed[key] = replaceIdentifiers(item, newIdentifiers)
+		}
+	case []interface{}:
+		for i, item := range typed {
+			typed[i] = replaceIdentifiers(item, newIdentifiers)
+		}
+	case string:
+		if newIdentifier, ok := newIdentifiers[typed]; ok {
+			return newIdentifier
+		}
+	}
+	return value
+}
+
+// orderByDependency sorts resources so that a resource whose model references the identifier of another resource in
+// the same region is created after it. Resources in a dependency cycle keep their archive order after everything else.
+func orderByDependency(resources []BackupResource) []BackupResource {
+	dependencies := make([][]int, len(resources))
+	for i, resource := range resources {
+		model := string(resource.Properties)
+		for j, other := range resources {
+			if i != j && other.Region == resource.Region && other.Identifier != "" && strings.Contains(model, fmt.Sprintf("%q", other.Identifier)) {
+				dependencies[i] = append(dependencies[i], j)
+			}
+		}
+	}
+
+	ordered := []BackupResource{}
+	done := make([]bool, len(resources))
+	for progress := true; progress; {
+		progress = false
+		for i := range resources {
+			if done[i] {
+				continue
+			}
+			ready := true
+			for _, j := range dependencies[i] {
+				ready = ready && done[j]
+			}
+			if ready {
+				ordered = append(ordered, resources[i])
+				done[i] = true
+				progress = true
+			}
+		}
+	}
+
+	for i, resource := range resources {
+		if !done[i] {
+			ordered = append(ordered, resource)
+		}
+	}
+	return ordered
+}
+
+// RenderRestoreResults prints a table of restore results, grouped by region
+func RenderRestoreResults(results []RestoreResult) {
+	byRegion := map[string][]RestoreResult{}
+	regions := []string{}
+	for _, result := range results {
+		if _, ok := byRegion[result.Resource.Region]; !ok {
+			regions = append(regions, result.Resource.Region)
+		}
+		byRegion[result.Resource.Region] = append(byRegion[result.Resource.Region], result)
+	}
+	sort.Strings(regions)
+
+	for _, region := range regions {
+		tableData := pterm.TableData{{"Resource", "Status", "NewIdentifier", "Notes", "Error"}}
+		for _, result := range byRegion[region] {
+			status := pterm.Yellow(result.Status)
+			switch result.Status {
+			case RestoreStatusRestored:
+				status = pterm.Green(result.Status)
+			case RestoreStatusFailed:
+				status = pterm.Red(result.Status)
+			}
+
+			errorMessage := ""
+			if result.Error != nil {
+				errorMessage = truncateText(result.Error.Error(), 60)
+			}
+			tableData = append(tableData, []string{
+				colorTypeAndIdentifier(result.Resource.TypeName, result.Resource.Identifier),
+				status,
+				result.NewIdentifier,
+				strings.Join(result.Notes, "; "),
+				errorMessage,
+			})
+		}
+
+		pterm.Println()
+		renderSection(fmt.Sprintf("Region: %s", region))
+		pterm.DefaultTable.
+			WithHasHeader().
+			WithData(tableData).
+			Render()
+		pterm.Println()
+	}
+}
@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/golang/mock/gomock"
+	mock_aws "github.com/gruntwork-io/cloud-nuke/aws/mocks/clients"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestorableModel(t *testing.T) {
+	t.Parallel()
+
+	resource := BackupResource{
+		Region:     "us-east-1",
+		TypeName:   "AWS::EC2::Subnet",
+		Identifier: "subnet-old",
+		Properties: json.RawMessage(`{"SubnetId":"subnet-old","VpcId":"vpc-old","CidrBlock":"10.0.0.0/24","Tags":[{"Key":"team","Value":"a","Arn":"x"}]}`),
+	}
+	schema := BackupSchema{Schema: json.RawMessage(`{
+		"readOnlyProperties": ["/properties/SubnetId", "/properties/Tags/*/Arn"],
+		"writeOnlyProperties": ["/properties/Password"]
+	}`)}
+
+	desiredState, notes, err := restorableModel(resource, schema, map[string]string{"vpc-old": "vpc-new"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"VpcId":"vpc-new","CidrBlock":"10.0.0.0/24","Tags":[{"Key":"team","Value":"a"}]}`, desiredState)
+	assert.Equal(t, []string{"write-only properties were not backed up: Password"}, notes)
+}
+
+func TestRestorableModelNotesMissingData(t *testing.T) {
+	t.Parallel()
+
+	resource := BackupResource{TypeName: "AWS::S3::Bucket", Properties: json.RawMessage(`{"BucketName":"my-bucket"}`)}
+
+	desiredState, notes, err := restorableModel(resource, BackupSchema{}, nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"BucketName":"my-bucket"}`, desiredState)
+	assert.Len(t, notes, 2)
+}
+
+func TestOrderByDependency(t *testing.T) {
+	t.Parallel()
+
+	resources := []BackupResource{
+		{Region: "us-east-1", TypeName: "AWS::EC2::Subnet", Identifier: "subnet-1", Properties: json.RawMessage(`{"VpcId":"vpc-1"}`)},
+		{Region: "us-east-1", TypeName: "AWS::EC2::Instance", Identifier: "i-1", Properties: json.RawMessage(`{"SubnetId":"subnet-1"}`)},
+		{Region: "us-east-1", TypeName: "AWS::EC2::VPC", Identifier: "vpc-1", Properties: json.RawMessage(`{"CidrBlock":"10.0.0.0/16"}`)},
+		{Region: "eu-west-1", TypeName: "AWS::EC2::VPC", Identifier: "vpc-2", Properties: json.RawMessage(`{"CidrBlock":"10.0.0.0/16"}`)},
+	}
+
+	identifiers := []string{}
+	for _, resource := range orderByDependency(resources) {
+		identifiers = append(identifiers, resource.Identifier)
+	}
+	assert.Equal(t, []string{"vpc-1", "vpc-2", "subnet-1", "i-1"}, identifiers)
+}
+
+func TestRestoreBackupDryRun(t *testing.T) {
+	t.Parallel()
+
+	backup := &Backup{
+		Resources: []BackupResource{
+			{Region: "us-east-1", TypeName: "AWS::Logs::LogGroup", Identifier: "a", Properties: json.RawMessage(`{"LogGroupName":"a"}`)},
+			{Region: "us-east-1", TypeName: "AWS::Logs::LogGroup", Identifier: "b", Error: "AccessDenied"},
+			{Region: "us-east-1", TypeName: "CloudNuke::EC2::Snapshot", Identifier: "snap-1", Properties: json.RawMessage(`{}`)},
+			{Region: "eu-west-1", TypeName: "AWS::Logs::LogGroup", Identifier: "c", Properties: json.RawMessage(`{"LogGroupName":"c"}`)},
+		},
+	}
+
+	results, err := RestoreBackup(context.Background(), backup, RestoreOptions{Regions: []string{"us-east-1"}, DryRun: true})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.Equal(t, RestoreStatusPlanned, results[0].Status)
+	assert.Equal(t, RestoreStatusSkipped, results[1].Status)
+	assert.Equal(t, RestoreStatusSkipped, results[2].Status)
+}
+
+func TestCheckBackupAccount(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, checkBackupAccount("111111111111", "111111111111"))
+	assert.Equal(t, BackupAccountMismatchError{BackupAccountID: "111111111111", AccountID: "222222222222"}, errors.Unwrap(checkBackupAccount("111111111111", "222222222222")))
+	assert.Equal(t, BackupAccountMismatchError{AccountID: "222222222222"}, errors.Unwrap(checkBackupAccount("", "222222222222")))
+}
+
+func TestRestoreBackupRejectsPluginTypes(t *testing.T) {
+	t.Parallel()
+
+	backup := &Backup{
+		Schemas: map[string]BackupSchema{"Acme::Queue::Queue": {Plugin: "/plugins/cloud-nuke-plugin-acme"}},
+		Resources: []BackupResource{
+			{Region: "us-east-1", TypeName: "Acme::Queue::Queue", Identifier: "queue-1", Properties: json.RawMessage(`{}`)},
+		},
+	}
+
+	results, err := RestoreBackup(context.Background(), backup, RestoreOptions{DryRun: true})
+	assert.Equal(t, RestoreFailedError{Count: 1}, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, UnrestorableResourceTypeError{TypeName: "Acme::Queue::Queue"}, errors.Unwrap(results[0].Error))
+}
+
+func TestRestoreBackupUsesNewClientTokensForEachRestore(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockCloudControl := mock_aws.NewMockCloudControlAPI(ctrl)
+	clientTokens := []string{}
+	mockCloudControl.EXPECT().
+		CreateResource(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, input *cloudcontrol.CreateResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.CreateResourceOutput, error) {
+			clientTokens = append(clientTokens, aws.ToString(input.ClientToken))
+			return nil, assert.AnError
+		}).
+		Times(2)
+
+	backup := &Backup{
+		AccountID: "111111111111",
+		Resources: []BackupResource{
+			{Region: "us-east-1", TypeName: "AWS::Logs::LogGroup", Identifier: "a", Properties: json.RawMessage(`{"LogGroupName":"a"}`)},
+		},
+	}
+
+	ctx := WithClientFactory(context.Background(), StaticClientFactory{CloudControlClient: mockCloudControl})
+	for i := 0; i < 2; i++ {
+		_, err := RestoreBackup(ctx, backup, RestoreOptions{AllowAccountMismatch: true})
+		assert.Equal(t, RestoreFailedError{Count: 1}, err)
+	}
+	require.Len(t, clientTokens, 2)
+	assert.NotEqual(t, clientTokens[0], clientTokens[1])
+}
@@ -0,0 +1,478 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/go-commons/collections"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/pterm/pterm"
+)
+
+// runSaveInterval is how long the changes made with Transition are batched before the run file is rewritten
+const runSaveInterval = time.Second
+
+// Run is the journal of a single cloud-nuke invocation. It records every resource that was planned for deletion and
+// the state of its deletion request, so that an interrupted run can be resumed with `--resume <run file>` and the
+// outcome of requests that were not waited on can be checked later with `cloud-nuke status <run file>`.
+type Run struct {
+	ID        string      `json:"id"`
+	StartedAt time.Time   `json:"started_at"`
+	Entries   []*RunEntry `json:"entries"`
+
+	path  string
+	mutex sync.Mutex
+	// dirty is true when entries changed since the run file was last written
+	dirty bool
+	// savedAt is when the run file was last written
+	savedAt time.Time
+	// saveTimer, when set, writes the pending changes once runSaveInterval has passed
+	saveTimer *time.Timer
+}
+
+// RunEntryState is the lifecycle state of a single resource in the run journal
+type RunEntryState string
+
+const (
+	// RunEntryPlanned - the resource was selected for deletion, but no request was submitted yet
+	RunEntryPlanned RunEntryState = "planned"
+	// RunEntrySubmitted - the deletion request was submitted and has not reached a terminal state yet
+	RunEntrySubmitted RunEntryState = "submitted"
+	// RunEntrySucceeded - the resource was deleted
+	RunEntrySucceeded RunEntryState = "succeeded"
+	// RunEntryFailed - the deletion request could not be submitted or finished unsuccessfully
+	RunEntryFailed RunEntryState = "failed"
+)
+
+// RunEntry is the journal entry of a single resource
+type RunEntry struct {
+	Region          string        `json:"region"`
+	TypeName        string        `json:"type_name"`
+	Identifier      string        `json:"identifier"`
+	State           RunEntryState `json:"state"`
+	RequestToken    string        `json:"request_token,omitempty"`
+	ClientToken     string        `json:"client_token,omitempty"`
+	Attempt         int           `json:"attempt,omitempty"`
+	OperationStatus string        `json:"operation_status,omitempty"`
+	StatusMessage   string        `json:"status_message,omitempty"`
+	ErrorCode       string        `json:"error_code,omitempty"`
+	Verification    Verification  `json:"verification,omitempty"`
+}
+
+// Failed returns true if the deletion request finished without removing the resource
+func (entry *RunEntry) Failed() bool {
+	return entry.State == RunEntryFailed
+}
+
+// NewRun creates an empty Run that will be persisted to the given path. If path is empty, the run file is written
+// to the working directory and named after the run ID.
+func NewRun(path string) *Run {
+	id := fmt.Sprintf("%s-%s", time.Now().UTC().Format("20060102T150405Z"), util.UniqueID())
+	if path == "" {
+		path = fmt.Sprintf("cloud-nuke-run-%s.json", id)
+	}
+
+	return &Run{
+		ID:        id,
+		StartedAt: time.Now().UTC(),
+		path:      path,
+	}
+}
+
+// LoadRun reads a run file previously written by Save
+func LoadRun(path string) (*Run, error) {
+	absolutePath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	contents, err := ioutil.ReadFile(absolutePath)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	run := &Run{}
+	if err := json.Unmarshal(contents, run); err != nil {
+		return nil, errors.WithStackTrace(InvalidRunFileError{Path: path, Underlying: err})
+	}
+	run.path = path
+
+	return run, nil
+}
+
+// Path returns the location the run file is written to
+func (run *Run) Path() string {
+	return run.path
+}
+
+// Plan adds a planned entry for every resource in the account that is not in the journal yet, and persists the
+// run file before any deletion request is submitted.
+func (run *Run) Plan(account *AwsAccountResources) error {
+	run.mutex.Lock()
+	for region, resourcesInRegion := range account.Resources {
+		for _, resources := range resourcesInRegion.Resources {
+			for _, identifier := range resources.ResourceIdentifiers() {
+				if run.find(region, resources.ResourceName(), identifier) == nil {
+					run.Entries = append(run.Entries, &RunEntry{
+						Region:     region,
+						TypeName:   resources.ResourceName(),
+						Identifier: identifier,
+						State:      RunEntryPlanned,
+					})
+				}
+			}
+		}
+	}
+	run.mutex.Unlock()
+
+	return run.Save()
+}
+
+// Transition applies a state change to the entry of the given resource, creating the entry if needed, and persists
+// the run file so that the change survives cloud-nuke being interrupted. To avoid rewriting the run file for every
+// resource, changes are written at most once per runSaveInterval: a change made sooner is written by a timer, or by
+// Flush. A change lost in between is harmless, because client tokens are derived from the run ID, so a resumed run
+// resubmits the same deletion requests.
+func (run *Run) Transition(region, typeName, identifier string, change func(entry *RunEntry)) error {
+	run.mutex.Lock()
+	defer run.mutex.Unlock()
+
+	entry := run.find(region, typeName, identifier)
+	if entry == nil {
+		entry = &RunEntry{
+			Region:     region,
+			TypeName:   typeName,
+			Identifier: identifier,
+			State:      RunEntryPlanned,
+		}
+		run.Entries = append(run.Entries, entry)
+	}
+	change(entry)
+	run.dirty = true
+
+	if time.Since(run.savedAt) >= runSaveInterval {
+		return run.save()
+	}
+	if run.saveTimer == nil {
+		run.saveTimer = time.AfterFunc(runSaveInterval, run.flush)
+	}
+	return nil
+}
+
+// record applies a state change like Transition, logging instead of returning errors so that a journal that can't
+// be written does not stop the deletion itself. It is a no-op on a nil Run.
+func (run *Run) record(region, typeName, identifier string, change func(entry *RunEntry)) {
+	if run == nil {
+		return
+	}
+	if err := run.Transition(region, typeName, identifier, change); err != nil {
+		logging.Logger.Errorf("Could not record the state of %s %s in run file %s: %s", typeName, identifier, run.Path(), err)
+	}
+}
+
+// find returns the entry of the given resource, or nil if there is none. The caller must hold the mutex.
+func (run *Run) find(region, typeName, identifier string) *RunEntry {
+	for _, entry := range run.Entries {
+		if entry.Region == region && entry.TypeName == typeName && entry.Identifier == identifier {
+			return entry
+		}
+	}
+	return nil
+}
+
+// Save writes the run file to disk
+func (run *Run) Save() error {
+	run.mutex.Lock()
+	defer run.mutex.Unlock()
+
+	return run.save()
+}
+
+// Flush writes the changes made with Transition that are not in the run file yet. It is a no-op on a nil Run.
+func (run *Run) Flush() error {
+	if run == nil {
+		return nil
+	}
+
+	run.mutex.Lock()
+	defer run.mutex.Unlock()
+
+	if !run.dirty {
+		return nil
+	}
+	return run.save()
+}
+
+// flush writes the pending changes like Flush, logging instead of returning errors. It is a no-op on a nil Run.
+func (run *Run) flush() {
+	if err := run.Flush(); err != nil {
+		logging.Logger.Errorf("Could not write run file %s: %s", run.Path(), err)
+	}
+}
+
+// save writes the run file to disk, cancelling any pending timed write. The caller must hold the mutex.
+func (run *Run) save() error {
+	if run.saveTimer != nil {
+		run.saveTimer.Stop()
+		run.saveTimer = nil
+	}
+
+	contents, err := json.MarshalIndent(run, "", "  ")
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	if err := writeFileAtomically(run.path, contents, 0644); err != nil {
+		return err
+	}
+	run.dirty = false
+	run.savedAt = time.Now()
+	return nil
+}
+
+// writeFileAtomically replaces the file at path with contents. The contents are written and synced to a temporary
+// file in the same directory, which is then renamed over path, so that a crash leaves either the previous or the new
+// file and never a truncated one.
+func writeFileAtomically(path string, contents []byte, perm os.FileMode) error {
+	file, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	tempPath := file.Name()
+	// Once renamed there is nothing left to remove
+	defer os.Remove(tempPath)
+
+	if _, err := file.Write(contents); err != nil {
+		file.Close()
+		return errors.WithStackTrace(err)
+	}
+	if err := file.Chmod(perm); err != nil {
+		file.Close()
+		return errors.WithStackTrace(err)
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return errors.WithStackTrace(err)
+	}
+	if err := file.Close(); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return errors.WithStackTrace(os.Rename(tempPath, path))
+}
+
+// PendingEntries returns the entries whose deletion request was submitted but has not reached a terminal state yet
+func (run *Run) PendingEntries() []*RunEntry {
+	return run.entriesInState(RunEntrySubmitted)
+}
+
+// FailedEntries returns the entries whose deletion request finished unsuccessfully
+func (run *Run) FailedEntries() []*RunEntry {
+	return run.entriesInState(RunEntryFailed)
+}
+
+func (run *Run) entriesInState(state RunEntryState) []*RunEntry {
+	run.mutex.Lock()
+	defer run.mutex.Unlock()
+
+	entries := []*RunEntry{}
+	for _, entry := range run.Entries {
+		if entry.State == state {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// RemainingEntries returns the entries of resources that were planned but never submitted, or whose deletion failed
+func (run *Run) RemainingEntries() []*RunEntry {
+	return append(run.entriesInState(RunEntryPlanned), run.entriesInState(RunEntryFailed)...)
+}
+
+// RemainingResources returns the resources of the journal that still have to be deleted: those that were planned
+// but never submitted, and those whose deletion failed.
+func (run *Run) RemainingResources() *AwsAccountResources {
+	account := &AwsAccountResources{
+		Resources: make(map[string]AwsRegionResource),
+	}
+
+	for _, entry := range run.RemainingEntries() {
+		account.add(entry.Region, entry.TypeName, entry.Identifier)
+	}
+
+	return account
+}
+
+// Regions returns the regions that have at least one entry in the journal
+func (run *Run) Regions() []string {
+	run.mutex.Lock()
+	defer run.mutex.Unlock()
+
+	regions := []string{}
+	for _, entry := range run.Entries {
+		if !collections.ListContainsElement(regions, entry.Region) {
+			regions = append(regions, entry.Region)
+		}
+	}
+	return regions
+}
+
+// RefreshRunStatus polls GetResourceRequestStatus for every request in the run that has not reached a terminal
+// state, updates the entries with the latest progress and saves the run file. When wait is true, it blocks until
+// each pending request completes or maxWaitDur elapses. Polling stops early when ctx is cancelled.
+func RefreshRunStatus(ctx context.Context, run *Run, wait bool, maxWaitDur time.Duration) error {
+	defer run.flush()
+
+	for _, entry := range run.PendingEntries() {
+		if ctx.Err() != nil {
+			return errors.WithStackTrace(ctx.Err())
+		}
+
+		config, err := newConfig(ctx, sessionRegion(ctx, entry.Region))
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		svc := clientsFrom(ctx).CloudControl(config)
+
+		statusInput := &cloudcontrol.GetResourceRequestStatusInput{
+			RequestToken: aws.String(entry.RequestToken),
+		}
+
+		if wait {
+			logging.Logger.Debugf("Waiting on deletion of resource type: %s with identifier: %s", entry.TypeName, entry.Identifier)
+			waiter := cloudcontrol.NewResourceRequestSuccessWaiter(svc, func(o *cloudcontrol.ResourceRequestSuccessWaiterOptions) {
+				o.Retryable = RetryGetResourceRequestStatus(nil)
+			})
+			if _, waitErr := waiter.WaitForOutput(ctx, statusInput, maxWaitDur); waitErr != nil {
+				logging.Logger.Debugf("Error waiting on output: %+v", waitErr)
+			}
+		}
+
+		statusCtx, cancelStatus := detachedContext()
+		statusOutput, err := svc.GetResourceRequestStatus(statusCtx, statusInput)
+		cancelStatus()
+		if err != nil {
+			logging.Logger.Errorf("Could not get status of request %s for %s %s: %s", entry.RequestToken, entry.TypeName, entry.Identifier, err)
+			continue
+		}
+
+		if err := run.Transition(entry.Region, entry.TypeName, entry.Identifier, func(entry *RunEntry) {
+			entry.update(statusOutput.ProgressEvent)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResumeRun reconciles the in-flight deletion requests of an interrupted run by waiting on their request tokens, and
+// returns the resources that still have to be deleted.
+func ResumeRun(ctx context.Context, run *Run, maxWaitDur time.Duration) (*AwsAccountResources, error) {
+	logging.Logger.Infof("Resuming run %s: checking %d in-flight deletion requests", run.ID, len(run.PendingEntries()))
+	if err := RefreshRunStatus(ctx, run, true, maxWaitDur); err != nil {
+		return nil, err
+	}
+
+	return run.RemainingResources(), nil
+}
+
+// update copies the state of a progress event onto the entry, moving it to a terminal state once Cloud Control will
+// not make any further progress on the request.
+func (entry *RunEntry) update(progressEvent *types.ProgressEvent) {
+	if progressEvent == nil {
+		return
+	}
+
+	wasFailed := entry.Failed()
+	entry.State = RunEntrySubmitted
+	if progressEvent.RequestToken != nil {
+		entry.RequestToken = aws.ToString(progressEvent.RequestToken)
+	}
+	entry.OperationStatus = string(progressEvent.OperationStatus)
+	entry.StatusMessage = aws.ToString(progressEvent.StatusMessage)
+	entry.ErrorCode = string(progressEvent.ErrorCode)
+
+	switch progressEvent.OperationStatus {
+	case types.OperationStatusSuccess:
+		entry.State = RunEntrySucceeded
+	case types.OperationStatusCancelComplete:
+		// The deletion was cancelled, so the resource still exists
+		entry.State = RunEntryFailed
+	case types.OperationStatusFailed:
+		entry.State = RunEntryFailed
+		if progressEvent.ErrorCode == types.HandlerErrorCodeNotFound && progressEvent.Operation == types.OperationDelete {
+			// Resource not found error on delete is OK.
+			entry.State = RunEntrySucceeded
+			entry.OperationStatus = string(types.OperationStatusSuccess)
+		}
+	}
+
+	if entry.Failed() && !wasFailed {
+		entry.Attempt++
+	}
+}
+
+// fail records that the deletion of the entry's resource could not be submitted
+func (entry *RunEntry) fail(err error) {
+	entry.markFailed()
+	entry.StatusMessage = err.Error()
+}
+
+// markFailed moves the entry to the failed state. The next deletion of a failed resource is a new attempt, which
+// needs a new client token.
+func (entry *RunEntry) markFailed() {
+	if !entry.Failed() {
+		entry.Attempt++
+	}
+	entry.State = RunEntryFailed
+}
+
+// RenderRunStatus prints a results table for the run, grouped by region
+func RenderRunStatus(run *Run) {
+	run.mutex.Lock()
+	defer run.mutex.Unlock()
+
+	byRegion := make(map[string][]*RunEntry)
+	regions := []string{}
+	for _, entry := range run.Entries {
+		if _, ok := byRegion[entry.Region]; !ok {
+			regions = append(regions, entry.Region)
+		}
+		byRegion[entry.Region] = append(byRegion[entry.Region], entry)
+	}
+
+	for _, region := range regions {
+		tableData := pterm.TableData{{"Resource", "State", "RequestToken", "Status", "StatusMessage", "ErrorCode", "Verification"}}
+		for _, entry := range byRegion[region] {
+			tableData = append(tableData, []string{
+				colorTypeAndIdentifier(entry.TypeName, entry.Identifier),
+				string(entry.State),
+				entry.RequestToken,
+				colorOperationStatus(entry.OperationStatus),
+				truncateText(entry.StatusMessage, 60),
+				entry.ErrorCode,
+				string(entry.Verification),
+			})
+		}
+
+		pterm.Println()
+		renderSection(fmt.Sprintf("Region: %s", region))
+		pterm.DefaultTable.
+			WithHasHeader().
+			WithData(tableData).
+			Render()
+		pterm.Println()
+	}
+}
@@ -0,0 +1,184 @@
+package aws
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunSaveAndLoad(t *testing.T) {
+	t.Parallel()
+
+	runFilePath := filepath.Join(t.TempDir(), "run.json")
+	run := NewRun(runFilePath)
+	require.Equal(t, runFilePath, run.Path())
+
+	require.NoError(t, run.Transition("us-east-1", "AWS::Logs::LogGroup", "my-log-group", func(entry *RunEntry) {
+		entry.update(&types.ProgressEvent{
+			Operation:       types.OperationDelete,
+			OperationStatus: types.OperationStatusInProgress,
+			RequestToken:    aws.String("token-1"),
+		})
+	}))
+	require.NoError(t, run.Flush())
+
+	loaded, err := LoadRun(runFilePath)
+	require.NoError(t, err)
+	assert.Equal(t, run.ID, loaded.ID)
+	assert.Equal(t, runFilePath, loaded.Path())
+	require.Len(t, loaded.Entries, 1)
+	assert.Equal(t, "token-1", loaded.Entries[0].RequestToken)
+	assert.Len(t, loaded.PendingEntries(), 1)
+}
+
+func TestLoadRunRejectsInvalidFile(t *testing.T) {
+	t.Parallel()
+
+	_, err := LoadRun("../config/mocks/garbage.yaml")
+	require.Error(t, err)
+}
+
+func TestRunEntryUpdate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		progressEvent  *types.ProgressEvent
+		expectedStatus string
+		expectedState  RunEntryState
+		expectedFailed bool
+	}{
+		{
+			name:           "in progress",
+			progressEvent:  &types.ProgressEvent{Operation: types.OperationDelete, OperationStatus: types.OperationStatusInProgress},
+			expectedStatus: "IN_PROGRESS",
+			expectedState:  RunEntrySubmitted,
+		},
+		{
+			name:           "success",
+			progressEvent:  &types.ProgressEvent{Operation: types.OperationDelete, OperationStatus: types.OperationStatusSuccess},
+			expectedStatus: "SUCCESS",
+			expectedState:  RunEntrySucceeded,
+		},
+		{
+			name: "failed",
+			progressEvent: &types.ProgressEvent{
+				Operation:       types.OperationDelete,
+				OperationStatus: types.OperationStatusFailed,
+				ErrorCode:       types.HandlerErrorCodeAccessDenied,
+				StatusMessage:   aws.String("denied"),
+			},
+			expectedStatus: "FAILED",
+			expectedState:  RunEntryFailed,
+			expectedFailed: true,
+		},
+		{
+			name: "not found on delete counts as success",
+			progressEvent: &types.ProgressEvent{
+				Operation:       types.OperationDelete,
+				OperationStatus: types.OperationStatusFailed,
+				ErrorCode:       types.HandlerErrorCodeNotFound,
+			},
+			expectedStatus: "SUCCESS",
+			expectedState:  RunEntrySucceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			entry := &RunEntry{}
+			entry.update(testCase.progressEvent)
+			assert.Equal(t, testCase.expectedStatus, entry.OperationStatus)
+			assert.Equal(t, testCase.expectedState, entry.State)
+			assert.Equal(t, testCase.expectedFailed, entry.Failed())
+		})
+	}
+}
+
+func TestRunPlanAndRemainingResources(t *testing.T) {
+	t.Parallel()
+
+	run := NewRun(filepath.Join(t.TempDir(), "run.json"))
+	account := &AwsAccountResources{
+		Resources: map[string]AwsRegionResource{
+			"us-east-1": {
+				Resources: []*AwsResource{
+					{TypeName: "AWS::Logs::LogGroup", Identifiers: []string{"group-a", "group-b", "group-c"}},
+					{TypeName: "AWS::SQS::Queue", Identifiers: []string{}},
+				},
+			},
+			"eu-west-1": {
+				Resources: []*AwsResource{
+					{TypeName: "AWS::EC2::KeyPair", Identifiers: []string{"key-a"}},
+				},
+			},
+		},
+	}
+	require.NoError(t, run.Plan(account))
+	require.Len(t, run.Entries, 4)
+	assert.ElementsMatch(t, []string{"us-east-1", "eu-west-1"}, run.Regions())
+
+	// Planning the same account again must not duplicate entries
+	require.NoError(t, run.Plan(account))
+	require.Len(t, run.Entries, 4)
+
+	require.NoError(t, run.Transition("us-east-1", "AWS::Logs::LogGroup", "group-a", func(entry *RunEntry) {
+		entry.update(&types.ProgressEvent{Operation: types.OperationDelete, OperationStatus: types.OperationStatusSuccess})
+	}))
+	require.NoError(t, run.Transition("us-east-1", "AWS::Logs::LogGroup", "group-b", func(entry *RunEntry) {
+		entry.update(&types.ProgressEvent{Operation: types.OperationDelete, OperationStatus: types.OperationStatusInProgress})
+	}))
+	require.NoError(t, run.Transition("eu-west-1", "AWS::EC2::KeyPair", "key-a", func(entry *RunEntry) {
+		entry.fail(errors.New("AccessDenied"))
+	}))
+
+	assert.Len(t, run.PendingEntries(), 1)
+	assert.Len(t, run.FailedEntries(), 1)
+
+	// Succeeded and in-flight resources are skipped, planned and failed ones are retried
+	remaining := run.RemainingResources()
+	assert.Equal(t, []string{"group-c"}, remaining.GetRegion("us-east-1").MapResourceNameToIdentifiers()["AWS::Logs::LogGroup"])
+	assert.Equal(t, []string{"key-a"}, remaining.GetRegion("eu-west-1").MapResourceNameToIdentifiers()["AWS::EC2::KeyPair"])
+
+	require.NoError(t, run.Flush())
+	loaded, err := LoadRun(run.Path())
+	require.NoError(t, err)
+	assert.Equal(t, run.Entries, loaded.Entries)
+}
+
+func TestRunBatchesSavesAndWritesAtomically(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	run := NewRun(filepath.Join(dir, "run.json"))
+
+	// The first change is written right away, the next ones are batched until the run is flushed
+	require.NoError(t, run.Transition("us-east-1", "AWS::Logs::LogGroup", "group-a", func(entry *RunEntry) {}))
+	require.NoError(t, run.Transition("us-east-1", "AWS::Logs::LogGroup", "group-b", func(entry *RunEntry) {}))
+	loaded, err := LoadRun(run.Path())
+	require.NoError(t, err)
+	assert.Len(t, loaded.Entries, 1)
+
+	require.NoError(t, run.Flush())
+	loaded, err = LoadRun(run.Path())
+	require.NoError(t, err)
+	assert.Len(t, loaded.Entries, 2)
+
+	// No temporary file is left next to the run file
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, "run.json", files[0].Name())
+	assert.Equal(t, os.FileMode(0644), files[0].Mode().Perm())
+
+	var nilRun *Run
+	assert.NoError(t, nilRun.Flush())
+}
@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/cloud-nuke/externalcreds"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// newSession returns an aws-sdk-go (v1) session with the same region, credentials and endpoint overrides as the given
+// config. It is used for the service APIs that cloud-nuke calls directly, rather than through Cloud Control.
+func newSession(config aws.Config) (*session.Session, error) {
+	sessionConfig := &awsgo.Config{
+		Region:           awsgo.String(config.Region),
+		EndpointResolver: endpoints.ResolverFunc(externalcreds.ResolveEndpoint),
+		// Stand-ins for S3, such as LocalStack, serve buckets under their path rather than as subdomains
+		S3ForcePathStyle: awsgo.Bool(externalcreds.Endpoint("s3") != ""),
+	}
+	if config.Credentials != nil {
+		sessionConfig.Credentials = credentials.NewCredentials(&credentialsProvider{provider: config.Credentials})
+	}
+
+	sess, err := session.NewSession(sessionConfig)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	// aws-sdk-go reports an endpoint that could not be resolved as a MissingEndpoint error, which hides why
+	sess.Handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: "cloudnuke.ResolveEndpointHandler",
+		Fn: func(r *request.Request) {
+			if r.ClientInfo.Endpoint != "" {
+				return
+			}
+			if _, err := externalcreds.ResolveEndpoint(r.ClientInfo.ServiceName, config.Region); err != nil {
+				r.Error = err
+			}
+		},
+	})
+	return sess, nil
+}
+
+// credentialsProvider exposes an aws-sdk-go-v2 credentials provider as an aws-sdk-go (v1) one
+type credentialsProvider struct {
+	provider  aws.CredentialsProvider
+	canExpire bool
+	expires   time.Time
+}
+
+func (p *credentialsProvider) Retrieve() (credentials.Value, error) {
+	creds, err := p.provider.Retrieve(context.Background())
+	if err != nil {
+		return credentials.Value{}, err
+	}
+
+	p.canExpire = creds.CanExpire
+	p.expires = creds.Expires
+
+	return credentials.Value{
+		AccessKeyID:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.SessionToken,
+		ProviderName:    creds.Source,
+	}, nil
+}
+
+func (p *credentialsProvider) IsExpired() bool {
+	return p.canExpire && time.Now().After(p.expires)
+}
@@ -0,0 +1,504 @@
+package aws
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+const (
+	// SnapshotRunIDTagKey tags the final snapshots taken before deleting a resource with the ID of the run
+	SnapshotRunIDTagKey = "cloud-nuke-run-id"
+	// SnapshotSourceTagKey tags the final snapshots with the type and identifier of the resource they were taken of
+	SnapshotSourceTagKey = "cloud-nuke-source"
+	// SnapshotExpiresAtTagKey tags the final snapshots with the time, in RFC 3339 format, after which a later run
+	// deletes them
+	SnapshotExpiresAtTagKey = "cloud-nuke-expires-at"
+
+	// finalSnapshotPrefix starts the names of the final snapshots
+	finalSnapshotPrefix = "cloud-nuke-final-"
+	// cacheSnapshotPollInterval is how often ElastiCache, which has no snapshot waiter, is polled
+	cacheSnapshotPollInterval = 15 * time.Second
+)
+
+// snapshotter takes a final snapshot of a resource, with the given name and tags, and waits until it is available. A
+// snapshot that already exists with the same run ID tag was taken by an earlier attempt of the same run, which was
+// interrupted, so the snapshotter waits for it instead of failing.
+type snapshotter func(ctx context.Context, services *ServiceClients, identifier, snapshotID string, tags map[string]string) error
+
+// errSnapshotCoveredByCluster is returned by snapshotDBInstance for the members of an Aurora cluster, which RDS can't
+// snapshot on their own, as their data is stored by the cluster
+var errSnapshotCoveredByCluster = stderrors.New("its data is stored by its Aurora cluster, which AWS::RDS::DBCluster snapshots")
+
+var snapshotters = map[string]snapshotter{
+	"AWS::RDS::DBInstance":               snapshotDBInstance,
+	"AWS::RDS::DBCluster":                snapshotDBCluster,
+	"AWS::EC2::Volume":                   snapshotEBSVolume,
+	"AWS::ElastiCache::CacheCluster":     snapshotCacheCluster,
+	"AWS::ElastiCache::ReplicationGroup": snapshotReplicationGroup,
+	"AWS::Redshift::Cluster":             snapshotRedshiftCluster,
+}
+
+// SnapshotResourceTypes returns the sorted resource types that a final snapshot can be taken of
+func SnapshotResourceTypes() []string {
+	typeNames := []string{}
+	for typeName := range snapshotters {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+	return typeNames
+}
+
+// takeFinalSnapshot snapshots a resource before it is deleted, if opts.SnapshotTypes selects its type. The snapshot
+// is tagged with the run ID and, if opts.SnapshotRetention is set, with the time after which it expires.
+func takeFinalSnapshot(ctx context.Context, config aws.Config, typeName, identifier string, opts NukeOptions) *PrepareStep {
+	snapshot, ok := snapshotters[typeName]
+	if !ok || !snapshotTypeSelected(opts.SnapshotTypes, typeName) {
+		return nil
+	}
+
+	runID := ""
+	if opts.Run != nil {
+		runID = opts.Run.ID
+	}
+	snapshotID := finalSnapshotID(runID, identifier)
+	step := &PrepareStep{Description: fmt.Sprintf("Create final snapshot %s", snapshotID)}
+
+	services, err := clientsFrom(ctx).Services(config)
+	if err != nil {
+		step.Error = err
+		return step
+	}
+
+	logging.Logger.Infof("Creating final snapshot %s of resource type: %s with identifier: %s", snapshotID, typeName, identifier)
+	step.Error = snapshot(ctx, services, identifier, snapshotID, finalSnapshotTags(runID, typeName, identifier, opts.SnapshotRetention, time.Now()))
+	if step.Error == errSnapshotCoveredByCluster {
+		step.Description = fmt.Sprintf("Skip final snapshot %s: %s", snapshotID, step.Error)
+		step.Error = nil
+	}
+	return step
+}
+
+func snapshotTypeSelected(snapshotTypes []string, typeName string) bool {
+	for _, selected := range snapshotTypes {
+		if selected == "all" || selected == typeName {
+			return true
+		}
+	}
+	return false
+}
+
+var invalidSnapshotIDCharacters = regexp.MustCompile(`[^a-z0-9]+`)
+
+// finalSnapshotID names the final snapshot of a resource. The name only uses lowercase letters, digits and single
+// hyphens, and starts with a letter, which every service accepts.
+func finalSnapshotID(runID, identifier string) string {
+	id := invalidSnapshotIDCharacters.ReplaceAllString(strings.ToLower(runID+"-"+identifier), "-")
+	id = strings.Trim(finalSnapshotPrefix+strings.Trim(id, "-"), "-")
+	if len(id) > 200 {
+		id = strings.TrimRight(id[:200], "-")
+	}
+	return id
+}
+
+func finalSnapshotTags(runID, typeName, identifier string, retention time.Duration, now time.Time) map[string]string {
+	tags := map[string]string{
+		SnapshotRunIDTagKey:  runID,
+		SnapshotSourceTagKey: fmt.Sprintf("%s/%s", typeName, identifier),
+	}
+	if retention > 0 {
+		tags[SnapshotExpiresAtTagKey] = now.Add(retention).UTC().Format(time.RFC3339)
+	}
+	return tags
+}
+
+// snapshotExpired returns true if the snapshot's tags carry an expiry time that has passed
+func snapshotExpired(tags map[string]string, now time.Time) bool {
+	expiresAt, err := time.Parse(time.RFC3339, tags[SnapshotExpiresAtTagKey])
+	return err == nil && now.After(expiresAt)
+}
+
+// resumedSnapshot returns nil if err reports that the snapshot already exists, with code alreadyExistsCode, and the
+// existing snapshot is tagged with the run ID of tags, as when a resumed run takes a final snapshot again. Otherwise,
+// including when the snapshot belongs to another run or its tags can't be read, it returns err.
+func resumedSnapshot(err error, alreadyExistsCode, snapshotID string, tags map[string]string, snapshotTags func() (map[string]string, error)) error {
+	var awsErr awserr.Error
+	if err == nil || !stderrors.As(err, &awsErr) || awsErr.Code() != alreadyExistsCode || tags[SnapshotRunIDTagKey] == "" {
+		return err
+	}
+
+	existingTags, tagsErr := snapshotTags()
+	if tagsErr != nil {
+		logging.Logger.Warnf("Could not read the tags of the existing snapshot %s: %s", snapshotID, tagsErr)
+		return err
+	}
+	if existingTags[SnapshotRunIDTagKey] != tags[SnapshotRunIDTagKey] {
+		return err
+	}
+
+	logging.Logger.Infof("Final snapshot %s was already created by this run, waiting for it to be available", snapshotID)
+	return nil
+}
+
+func snapshotDBInstance(ctx context.Context, services *ServiceClients, identifier, snapshotID string, tags map[string]string) error {
+	svc := services.RDS
+	instances, err := svc.DescribeDBInstancesWithContext(ctx, &rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: awsgo.String(identifier),
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	for _, instance := range instances.DBInstances {
+		if awsgo.StringValue(instance.DBClusterIdentifier) != "" {
+			return errSnapshotCoveredByCluster
+		}
+	}
+
+	_, err = svc.CreateDBSnapshotWithContext(ctx, &rds.CreateDBSnapshotInput{
+		DBInstanceIdentifier: awsgo.String(identifier),
+		DBSnapshotIdentifier: awsgo.String(snapshotID),
+		Tags:                 rdsTags(tags),
+	})
+	err = resumedSnapshot(err, rds.ErrCodeDBSnapshotAlreadyExistsFault, snapshotID, tags, func() (map[string]string, error) {
+		output, err := svc.DescribeDBSnapshotsWithContext(ctx, &rds.DescribeDBSnapshotsInput{DBSnapshotIdentifier: awsgo.String(snapshotID)})
+		if err != nil || len(output.DBSnapshots) == 0 {
+			return nil, err
+		}
+		return rdsTagMap(output.DBSnapshots[0].TagList), nil
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return errors.WithStackTrace(svc.WaitUntilDBSnapshotAvailableWithContext(ctx, &rds.DescribeDBSnapshotsInput{
+		DBSnapshotIdentifier: awsgo.String(snapshotID),
+	}))
+}
+
+func snapshotDBCluster(ctx context.Context, services *ServiceClients, identifier, snapshotID string, tags map[string]string) error {
+	svc := services.RDS
+	_, err := svc.CreateDBClusterSnapshotWithContext(ctx, &rds.CreateDBClusterSnapshotInput{
+		DBClusterIdentifier:         awsgo.String(identifier),
+		DBClusterSnapshotIdentifier: awsgo.String(snapshotID),
+		Tags:                        rdsTags(tags),
+	})
+	err = resumedSnapshot(err, rds.ErrCodeDBClusterSnapshotAlreadyExistsFault, snapshotID, tags, func() (map[string]string, error) {
+		output, err := svc.DescribeDBClusterSnapshotsWithContext(ctx, &rds.DescribeDBClusterSnapshotsInput{DBClusterSnapshotIdentifier: awsgo.String(snapshotID)})
+		if err != nil || len(output.DBClusterSnapshots) == 0 {
+			return nil, err
+		}
+		return rdsTagMap(output.DBClusterSnapshots[0].TagList), nil
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return errors.WithStackTrace(svc.WaitUntilDBClusterSnapshotAvailableWithContext(ctx, &rds.DescribeDBClusterSnapshotsInput{
+		DBClusterSnapshotIdentifier: awsgo.String(snapshotID),
+	}))
+}
+
+func snapshotEBSVolume(ctx context.Context, services *ServiceClients, identifier, snapshotID string, tags map[string]string) error {
+	svc := services.EC2
+	ec2Tags := []*ec2.Tag{{Key: awsgo.String("Name"), Value: awsgo.String(snapshotID)}}
+	for key, value := range tags {
+		ec2Tags = append(ec2Tags, &ec2.Tag{Key: awsgo.String(key), Value: awsgo.String(value)})
+	}
+
+	output, err := svc.CreateSnapshotWithContext(ctx, &ec2.CreateSnapshotInput{
+		VolumeId:    awsgo.String(identifier),
+		Description: awsgo.String(fmt.Sprintf("Final snapshot of %s taken by cloud-nuke", identifier)),
+		TagSpecifications: []*ec2.TagSpecification{
+			{ResourceType: awsgo.String(ec2.ResourceTypeSnapshot), Tags: ec2Tags},
+		},
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return errors.WithStackTrace(svc.WaitUntilSnapshotCompletedWithContext(ctx, &ec2.DescribeSnapshotsInput{
+		SnapshotIds: []*string{output.SnapshotId},
+	}))
+}
+
+func snapshotCacheCluster(ctx context.Context, services *ServiceClients, identifier, snapshotID string, tags map[string]string) error {
+	return createCacheSnapshot(ctx, services.ElastiCache, &elasticache.CreateSnapshotInput{
+		CacheClusterId: awsgo.String(identifier),
+		SnapshotName:   awsgo.String(snapshotID),
+		Tags:           elasticacheTags(tags),
+	})
+}
+
+func snapshotReplicationGroup(ctx context.Context, services *ServiceClients, identifier, snapshotID string, tags map[string]string) error {
+	return createCacheSnapshot(ctx, services.ElastiCache, &elasticache.CreateSnapshotInput{
+		ReplicationGroupId: awsgo.String(identifier),
+		SnapshotName:       awsgo.String(snapshotID),
+		Tags:               elasticacheTags(tags),
+	})
+}
+
+// createCacheSnapshot creates an ElastiCache snapshot and polls it until it is available, as ElastiCache has no
+// snapshot waiter
+func createCacheSnapshot(ctx context.Context, svc elasticacheiface.ElastiCacheAPI, input *elasticache.CreateSnapshotInput) error {
+	_, err := svc.CreateSnapshotWithContext(ctx, input)
+	err = resumedSnapshot(err, elasticache.ErrCodeSnapshotAlreadyExistsFault, awsgo.StringValue(input.SnapshotName), elasticacheTagMap(input.Tags), func() (map[string]string, error) {
+		output, err := svc.DescribeSnapshotsWithContext(ctx, &elasticache.DescribeSnapshotsInput{SnapshotName: input.SnapshotName})
+		if err != nil || len(output.Snapshots) == 0 {
+			return nil, err
+		}
+		tags, err := svc.ListTagsForResourceWithContext(ctx, &elasticache.ListTagsForResourceInput{ResourceName: output.Snapshots[0].ARN})
+		if err != nil {
+			return nil, err
+		}
+		return elasticacheTagMap(tags.TagList), nil
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for {
+		output, err := svc.DescribeSnapshotsWithContext(ctx, &elasticache.DescribeSnapshotsInput{SnapshotName: input.SnapshotName})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		for _, snapshot := range output.Snapshots {
+			switch status := awsgo.StringValue(snapshot.SnapshotStatus); status {
+			case "available":
+				return nil
+			case "failed":
+				return errors.WithStackTrace(fmt.Errorf("snapshot %s is in state %s", awsgo.StringValue(input.SnapshotName), status))
+			}
+		}
+
+		sleepWithContext(ctx, cacheSnapshotPollInterval)
+		if ctx.Err() != nil {
+			return errors.WithStackTrace(ctx.Err())
+		}
+	}
+}
+
+func snapshotRedshiftCluster(ctx context.Context, services *ServiceClients, identifier, snapshotID string, tags map[string]string) error {
+	svc := services.Redshift
+	redshiftTags := []*redshift.Tag{}
+	for key, value := range tags {
+		redshiftTags = append(redshiftTags, &redshift.Tag{Key: awsgo.String(key), Value: awsgo.String(value)})
+	}
+
+	_, err := svc.CreateClusterSnapshotWithContext(ctx, &redshift.CreateClusterSnapshotInput{
+		ClusterIdentifier:  awsgo.String(identifier),
+		SnapshotIdentifier: awsgo.String(snapshotID),
+		Tags:               redshiftTags,
+	})
+	err = resumedSnapshot(err, redshift.ErrCodeClusterSnapshotAlreadyExistsFault, snapshotID, tags, func() (map[string]string, error) {
+		output, err := svc.DescribeClusterSnapshotsWithContext(ctx, &redshift.DescribeClusterSnapshotsInput{SnapshotIdentifier: awsgo.String(snapshotID)})
+		if err != nil || len(output.Snapshots) == 0 {
+			return nil, err
+		}
+		return redshiftTagMap(output.Snapshots[0].Tags), nil
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return errors.WithStackTrace(svc.WaitUntilSnapshotAvailableWithContext(ctx, &redshift.DescribeClusterSnapshotsInput{
+		SnapshotIdentifier: awsgo.String(snapshotID),
+	}))
+}
+
+func rdsTags(tags map[string]string) []*rds.Tag {
+	rdsTags := []*rds.Tag{}
+	for key, value := range tags {
+		rdsTags = append(rdsTags, &rds.Tag{Key: awsgo.String(key), Value: awsgo.String(value)})
+	}
+	return rdsTags
+}
+
+func elasticacheTags(tags map[string]string) []*elasticache.Tag {
+	elasticacheTags := []*elasticache.Tag{}
+	for key, value := range tags {
+		elasticacheTags = append(elasticacheTags, &elasticache.Tag{Key: awsgo.String(key), Value: awsgo.String(value)})
+	}
+	return elasticacheTags
+}
+
+// Kinds of final snapshots, used as the prefix of ExpiredFinalSnapshots identifiers
+const (
+	dbSnapshotKind        = "rds-db-snapshot"
+	dbClusterSnapshotKind = "rds-cluster-snapshot"
+	ebsSnapshotKind       = "ebs-snapshot"
+	cacheSnapshotKind     = "elasticache-snapshot"
+	redshiftSnapshotKind  = "redshift-snapshot"
+)
+
+// finalSnapshotIDSeparator separates the kind of a final snapshot from its ID in ExpiredFinalSnapshots identifiers
+const finalSnapshotIDSeparator = "/"
+
+// ExpiredFinalSnapshots - represents the final snapshots taken by earlier runs whose retention has passed. Their
+// identifiers are the kind of snapshot and its ID, such as rds-db-snapshot/cloud-nuke-final-...
+type ExpiredFinalSnapshots struct{}
+
+// TypeName - the name of the resource type, as passed to --resource-type
+func (snapshots ExpiredFinalSnapshots) TypeName() string {
+	return CustomResourceTypePrefix + "FinalSnapshot::Expired"
+}
+
+// List - returns the final snapshots whose expiry time has passed
+func (snapshots ExpiredFinalSnapshots) List(ctx context.Context, config aws.Config) ([]string, error) {
+	services, err := clientsFrom(ctx).Services(config)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	identifiers := []string{}
+	collect := func(kind, id string, tags map[string]string) {
+		if snapshotExpired(tags, now) {
+			identifiers = append(identifiers, kind+finalSnapshotIDSeparator+id)
+		}
+	}
+
+	rdsSvc := services.RDS
+	err = rdsSvc.DescribeDBSnapshotsPagesWithContext(ctx, &rds.DescribeDBSnapshotsInput{SnapshotType: awsgo.String("manual")}, func(page *rds.DescribeDBSnapshotsOutput, lastPage bool) bool {
+		for _, snapshot := range page.DBSnapshots {
+			collect(dbSnapshotKind, awsgo.StringValue(snapshot.DBSnapshotIdentifier), rdsTagMap(snapshot.TagList))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	err = rdsSvc.DescribeDBClusterSnapshotsPagesWithContext(ctx, &rds.DescribeDBClusterSnapshotsInput{SnapshotType: awsgo.String("manual")}, func(page *rds.DescribeDBClusterSnapshotsOutput, lastPage bool) bool {
+		for _, snapshot := range page.DBClusterSnapshots {
+			collect(dbClusterSnapshotKind, awsgo.StringValue(snapshot.DBClusterSnapshotIdentifier), rdsTagMap(snapshot.TagList))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	err = services.EC2.DescribeSnapshotsPagesWithContext(ctx, &ec2.DescribeSnapshotsInput{
+		OwnerIds: awsgo.StringSlice([]string{"self"}),
+		Filters: []*ec2.Filter{
+			{Name: awsgo.String("tag-key"), Values: awsgo.StringSlice([]string{SnapshotExpiresAtTagKey})},
+		},
+	}, func(page *ec2.DescribeSnapshotsOutput, lastPage bool) bool {
+		for _, snapshot := range page.Snapshots {
+			collect(ebsSnapshotKind, awsgo.StringValue(snapshot.SnapshotId), ec2TagMap(snapshot.Tags))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	cacheSvc := services.ElastiCache
+	cacheSnapshots := []*elasticache.Snapshot{}
+	err = cacheSvc.DescribeSnapshotsPagesWithContext(ctx, &elasticache.DescribeSnapshotsInput{SnapshotSource: awsgo.String("manual")}, func(page *elasticache.DescribeSnapshotsOutput, lastPage bool) bool {
+		cacheSnapshots = append(cacheSnapshots, page.Snapshots...)
+		return true
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	for _, snapshot := range cacheSnapshots {
+		// Listing the tags of ElastiCache snapshots takes a call per snapshot, so only look at those cloud-nuke named
+		if !strings.HasPrefix(awsgo.StringValue(snapshot.SnapshotName), finalSnapshotPrefix) {
+			continue
+		}
+		tags, err := cacheSvc.ListTagsForResourceWithContext(ctx, &elasticache.ListTagsForResourceInput{ResourceName: snapshot.ARN})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		collect(cacheSnapshotKind, awsgo.StringValue(snapshot.SnapshotName), elasticacheTagMap(tags.TagList))
+	}
+
+	err = services.Redshift.DescribeClusterSnapshotsPagesWithContext(ctx, &redshift.DescribeClusterSnapshotsInput{
+		SnapshotType: awsgo.String("manual"),
+		TagKeys:      awsgo.StringSlice([]string{SnapshotExpiresAtTagKey}),
+	}, func(page *redshift.DescribeClusterSnapshotsOutput, lastPage bool) bool {
+		for _, snapshot := range page.Snapshots {
+			collect(redshiftSnapshotKind, awsgo.StringValue(snapshot.SnapshotIdentifier), redshiftTagMap(snapshot.Tags))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return identifiers, nil
+}
+
+// Delete - deletes an expired final snapshot
+func (snapshots ExpiredFinalSnapshots) Delete(ctx context.Context, config aws.Config, identifier string) error {
+	services, err := clientsFrom(ctx).Services(config)
+	if err != nil {
+		return err
+	}
+
+	parts := strings.SplitN(identifier, finalSnapshotIDSeparator, 2)
+	if len(parts) != 2 {
+		return errors.WithStackTrace(fmt.Errorf("invalid final snapshot identifier %s", identifier))
+	}
+	kind, id := parts[0], awsgo.String(parts[1])
+
+	switch kind {
+	case dbSnapshotKind:
+		_, err = services.RDS.DeleteDBSnapshotWithContext(ctx, &rds.DeleteDBSnapshotInput{DBSnapshotIdentifier: id})
+	case dbClusterSnapshotKind:
+		_, err = services.RDS.DeleteDBClusterSnapshotWithContext(ctx, &rds.DeleteDBClusterSnapshotInput{DBClusterSnapshotIdentifier: id})
+	case ebsSnapshotKind:
+		_, err = services.EC2.DeleteSnapshotWithContext(ctx, &ec2.DeleteSnapshotInput{SnapshotId: id})
+	case cacheSnapshotKind:
+		_, err = services.ElastiCache.DeleteSnapshotWithContext(ctx, &elasticache.DeleteSnapshotInput{SnapshotName: id})
+	case redshiftSnapshotKind:
+		_, err = services.Redshift.DeleteClusterSnapshotWithContext(ctx, &redshift.DeleteClusterSnapshotInput{SnapshotIdentifier: id})
+	default:
+		return errors.WithStackTrace(fmt.Errorf("invalid final snapshot identifier %s", identifier))
+	}
+	return errors.WithStackTrace(err)
+}
+
+func rdsTagMap(tags []*rds.Tag) map[string]string {
+	tagMap := map[string]string{}
+	for _, tag := range tags {
+		tagMap[awsgo.StringValue(tag.Key)] = awsgo.StringValue(tag.Value)
+	}
+	return tagMap
+}
+
+func ec2TagMap(tags []*ec2.Tag) map[string]string {
+	tagMap := map[string]string{}
+	for _, tag := range tags {
+		tagMap[awsgo.StringValue(tag.Key)] = awsgo.StringValue(tag.Value)
+	}
+	return tagMap
+}
+
+func elasticacheTagMap(tags []*elasticache.Tag) map[string]string {
+	tagMap := map[string]string{}
+	for _, tag := range tags {
+		tagMap[awsgo.StringValue(tag.Key)] = awsgo.StringValue(tag.Value)
+	}
+	return tagMap
+}
+
+func redshiftTagMap(tags []*redshift.Tag) map[string]string {
+	tagMap := map[string]string{}
+	for _, tag := range tags {
+		tagMap[awsgo.StringValue(tag.Key)] = awsgo.StringValue(tag.Value)
+	}
+	return tagMap
+}
@@ -0,0 +1,220 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/golang/mock/gomock"
+	mock_aws "github.com/gruntwork-io/cloud-nuke/aws/mocks/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinalSnapshotID(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "cloud-nuke-final-20220801t120000z-a1b2c3-my-db", finalSnapshotID("20220801T120000Z-a1B2c3", "My_DB"))
+	assert.Equal(t, "cloud-nuke-final-vol-123", finalSnapshotID("", "vol-123"))
+	assert.LessOrEqual(t, len(finalSnapshotID("run", string(make([]byte, 300)))), 200)
+}
+
+func TestFinalSnapshotTagsAndExpiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	tags := finalSnapshotTags("run-1", "AWS::RDS::DBInstance", "my-db", 7*24*time.Hour, now)
+	assert.Equal(t, map[string]string{
+		SnapshotRunIDTagKey:     "run-1",
+		SnapshotSourceTagKey:    "AWS::RDS::DBInstance/my-db",
+		SnapshotExpiresAtTagKey: "2022-08-08T12:00:00Z",
+	}, tags)
+
+	assert.False(t, snapshotExpired(tags, now.Add(6*24*time.Hour)))
+	assert.True(t, snapshotExpired(tags, now.Add(8*24*time.Hour)))
+
+	kept := finalSnapshotTags("run-1", "AWS::RDS::DBInstance", "my-db", 0, now)
+	assert.NotContains(t, kept, SnapshotExpiresAtTagKey)
+	assert.False(t, snapshotExpired(kept, now.Add(365*24*time.Hour)))
+}
+
+func TestSnapshotTypeSelected(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, snapshotTypeSelected([]string{"all"}, "AWS::EC2::Volume"))
+	assert.True(t, snapshotTypeSelected([]string{"AWS::EC2::Volume"}, "AWS::EC2::Volume"))
+	assert.False(t, snapshotTypeSelected([]string{"AWS::RDS::DBInstance"}, "AWS::EC2::Volume"))
+	assert.False(t, snapshotTypeSelected(nil, "AWS::EC2::Volume"))
+}
+
+// TestFailedSnapshotOnlySkipsItsResource replaces the global snapshotters, so it doesn't run in parallel
+func TestFailedSnapshotOnlySkipsItsResource(t *testing.T) {
+	snapshotType := "Test::Snapshot::Database"
+	snapshotters[snapshotType] = func(ctx context.Context, services *ServiceClients, identifier, snapshotID string, tags map[string]string) error {
+		if identifier == "db-a" {
+			return errors.New("SnapshotQuotaExceeded")
+		}
+		return nil
+	}
+	t.Cleanup(func() { delete(snapshotters, snapshotType) })
+
+	ctrl := gomock.NewController(t)
+	mockCloudControl := mock_aws.NewMockCloudControlAPI(ctrl)
+	deleted := make(chan string, 3)
+	mockCloudControl.EXPECT().
+		DeleteResource(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, input *cloudcontrol.DeleteResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.DeleteResourceOutput, error) {
+			deleted <- aws.ToString(input.Identifier)
+			return &cloudcontrol.DeleteResourceOutput{ProgressEvent: &types.ProgressEvent{
+				RequestToken:    aws.String("request-" + aws.ToString(input.Identifier)),
+				Operation:       types.OperationDelete,
+				OperationStatus: types.OperationStatusInProgress,
+			}}, nil
+		}).
+		Times(2)
+
+	account := &AwsAccountResources{
+		Resources: map[string]AwsRegionResource{
+			"us-east-1": {
+				Resources: []*AwsResource{{TypeName: snapshotType, Identifiers: []string{"db-a", "db-b"}}},
+			},
+			"eu-west-1": {
+				Resources: []*AwsResource{{TypeName: snapshotType, Identifiers: []string{"db-c"}}},
+			},
+		},
+	}
+
+	run := NewRun(filepath.Join(t.TempDir(), "run.json"))
+	require.NoError(t, run.Plan(account))
+
+	ctx := WithClientFactory(context.Background(), StaticClientFactory{CloudControlClient: mockCloudControl})
+	err := NukeAllResources(ctx, account, []string{"us-east-1", "eu-west-1"}, NukeOptions{
+		Run:           run,
+		Async:         true,
+		SnapshotTypes: []string{"all"},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "SnapshotQuotaExceeded")
+
+	close(deleted)
+	deletedIdentifiers := []string{}
+	for identifier := range deleted {
+		deletedIdentifiers = append(deletedIdentifiers, identifier)
+	}
+	assert.ElementsMatch(t, []string{"db-b", "db-c"}, deletedIdentifiers)
+
+	failed := run.FailedEntries()
+	require.Len(t, failed, 1)
+	assert.Equal(t, "db-a", failed[0].Identifier)
+}
+
+// fakeSnapshotRDS is an RDS client that creates DB snapshots, and fails to create one that already exists as RDS does
+type fakeSnapshotRDS struct {
+	rdsiface.RDSAPI
+	// clusterIdentifier is the Aurora cluster of every instance, if any
+	clusterIdentifier string
+	// snapshots are the tags of the existing snapshots, by identifier
+	snapshots map[string]map[string]string
+	created   []string
+	waited    []string
+}
+
+func (svc *fakeSnapshotRDS) DescribeDBInstancesWithContext(ctx awsgo.Context, input *rds.DescribeDBInstancesInput, opts ...request.Option) (*rds.DescribeDBInstancesOutput, error) {
+	instance := &rds.DBInstance{DBInstanceIdentifier: input.DBInstanceIdentifier}
+	if svc.clusterIdentifier != "" {
+		instance.DBClusterIdentifier = awsgo.String(svc.clusterIdentifier)
+	}
+	return &rds.DescribeDBInstancesOutput{DBInstances: []*rds.DBInstance{instance}}, nil
+}
+
+func (svc *fakeSnapshotRDS) CreateDBSnapshotWithContext(ctx awsgo.Context, input *rds.CreateDBSnapshotInput, opts ...request.Option) (*rds.CreateDBSnapshotOutput, error) {
+	snapshotID := awsgo.StringValue(input.DBSnapshotIdentifier)
+	if _, ok := svc.snapshots[snapshotID]; ok {
+		return nil, awserr.New(rds.ErrCodeDBSnapshotAlreadyExistsFault, "Cannot create the snapshot because a snapshot with the identifier "+snapshotID+" already exists.", nil)
+	}
+	svc.snapshots[snapshotID] = rdsTagMap(input.Tags)
+	svc.created = append(svc.created, snapshotID)
+	return &rds.CreateDBSnapshotOutput{}, nil
+}
+
+func (svc *fakeSnapshotRDS) DescribeDBSnapshotsWithContext(ctx awsgo.Context, input *rds.DescribeDBSnapshotsInput, opts ...request.Option) (*rds.DescribeDBSnapshotsOutput, error) {
+	snapshotID := awsgo.StringValue(input.DBSnapshotIdentifier)
+	tags, ok := svc.snapshots[snapshotID]
+	if !ok {
+		return &rds.DescribeDBSnapshotsOutput{}, nil
+	}
+	return &rds.DescribeDBSnapshotsOutput{DBSnapshots: []*rds.DBSnapshot{
+		{DBSnapshotIdentifier: input.DBSnapshotIdentifier, TagList: rdsTags(tags)},
+	}}, nil
+}
+
+func (svc *fakeSnapshotRDS) WaitUntilDBSnapshotAvailableWithContext(ctx awsgo.Context, input *rds.DescribeDBSnapshotsInput, opts ...request.WaiterOption) error {
+	svc.waited = append(svc.waited, awsgo.StringValue(input.DBSnapshotIdentifier))
+	return nil
+}
+
+func TestResumedRunWaitsForItsFinalSnapshot(t *testing.T) {
+	t.Parallel()
+
+	svc := &fakeSnapshotRDS{snapshots: map[string]map[string]string{}}
+	ctx := WithClientFactory(context.Background(), StaticClientFactory{ServiceClients: ServiceClients{RDS: svc}})
+	account := &AwsAccountResources{
+		Resources: map[string]AwsRegionResource{
+			"us-east-1": {Resources: []*AwsResource{{TypeName: "AWS::RDS::DBInstance", Identifiers: []string{"db-a"}}}},
+		},
+	}
+	run := NewRun(filepath.Join(t.TempDir(), "run.json"))
+	require.NoError(t, run.Plan(account))
+
+	// The first attempt creates the snapshot, then is interrupted before deleting the instance
+	step := takeFinalSnapshot(ctx, aws.Config{}, "AWS::RDS::DBInstance", "db-a", NukeOptions{Run: run, SnapshotTypes: []string{"all"}})
+	require.NotNil(t, step)
+	require.NoError(t, step.Error)
+
+	// The resumed run keeps the run ID, so it names the snapshot the same and waits for the existing one
+	resumed, err := LoadRun(run.Path())
+	require.NoError(t, err)
+	step = takeFinalSnapshot(ctx, aws.Config{}, "AWS::RDS::DBInstance", "db-a", NukeOptions{Run: resumed, SnapshotTypes: []string{"all"}})
+	require.NotNil(t, step)
+	require.NoError(t, step.Error)
+
+	snapshotID := finalSnapshotID(run.ID, "db-a")
+	assert.Equal(t, []string{snapshotID}, svc.created)
+	assert.Equal(t, []string{snapshotID, snapshotID}, svc.waited)
+}
+
+func TestExistingSnapshotOfAnotherRunFails(t *testing.T) {
+	t.Parallel()
+
+	svc := &fakeSnapshotRDS{snapshots: map[string]map[string]string{
+		"cloud-nuke-final-db-a": {SnapshotRunIDTagKey: "other-run"},
+	}}
+	tags := finalSnapshotTags("this-run", "AWS::RDS::DBInstance", "db-a", 0, time.Now())
+	err := snapshotDBInstance(context.Background(), &ServiceClients{RDS: svc}, "db-a", "cloud-nuke-final-db-a", tags)
+	assert.Contains(t, err.Error(), rds.ErrCodeDBSnapshotAlreadyExistsFault)
+	assert.Empty(t, svc.waited)
+}
+
+func TestAuroraClusterMembersAreNotSnapshotted(t *testing.T) {
+	t.Parallel()
+
+	svc := &fakeSnapshotRDS{clusterIdentifier: "cluster-a", snapshots: map[string]map[string]string{}}
+	ctx := WithClientFactory(context.Background(), StaticClientFactory{ServiceClients: ServiceClients{RDS: svc}})
+
+	step := takeFinalSnapshot(ctx, aws.Config{}, "AWS::RDS::DBInstance", "cluster-a-instance-1", NukeOptions{SnapshotTypes: []string{"all"}})
+	require.NotNil(t, step)
+	assert.NoError(t, step.Error)
+	assert.Contains(t, step.Description, "Skip final snapshot")
+	assert.Empty(t, svc.created)
+}
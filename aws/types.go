@@ -4,8 +4,6 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
-	"os"
-	"path/filepath"
 	"strings"
 	"sync"
 	"time"
@@ -26,6 +24,25 @@ type AwsAccountResources struct {
 	Resources map[string]AwsRegionResource
 }
 
+// add appends a resource to the account, grouping it with the other resources of its region and type
+func (account *AwsAccountResources) add(region, typeName, identifier string) {
+	resourcesInRegion := account.Resources[region]
+
+	var resources *AwsResource
+	for _, existing := range resourcesInRegion.Resources {
+		if existing.TypeName == typeName {
+			resources = existing
+		}
+	}
+	if resources == nil {
+		resources = &AwsResource{TypeName: typeName}
+		resourcesInRegion.Resources = append(resourcesInRegion.Resources, resources)
+	}
+	resources.Identifiers = append(resources.Identifiers, identifier)
+
+	account.Resources[region] = resourcesInRegion
+}
+
 type ResourceTypeString string
 
 func (r ResourceTypeString) String() string {
@@ -43,28 +60,17 @@ func (a *AwsAccountResources) GetRegion(region string) AwsRegionResource {
 	return AwsRegionResource{}
 }
 
-func LoadNukePlan() (*ResourcesToNuke, error) {
-	cwd, err := os.Getwd()
+// LoadNukePlan reads the resource types to nuke from a YAML file with a ResourcesToNuke list, such as nuke-plan.yml
+func LoadNukePlan(path string) (*ResourcesToNuke, error) {
+	contents, err := ioutil.ReadFile(path)
 	if err != nil {
-		return nil, err
-	}
-	resourcesToNuke := &ResourcesToNuke{}
-	nukePlanPath := filepath.Join(cwd, "nuke-plan.yml")
-	f, openErr := os.Open(nukePlanPath)
-	if openErr != nil {
-		return nil, openErr
-	}
-
-	b, readErr := ioutil.ReadAll(f)
-	if readErr != nil {
-		return nil, readErr
+		return nil, errors.WithStackTrace(err)
 	}
 
-	unmarshalErr := yaml.Unmarshal(b, resourcesToNuke)
-	if unmarshalErr != nil {
-		return nil, unmarshalErr
+	resourcesToNuke := &ResourcesToNuke{}
+	if err := yaml.Unmarshal(contents, resourcesToNuke); err != nil {
+		return nil, errors.WithStackTrace(InvalidNukePlanError{Path: path, Reason: err.Error()})
 	}
-
 	return resourcesToNuke, nil
 }
 
@@ -125,17 +131,43 @@ func (a AwsResource) MaxBatchSize() int {
 	return 50
 }
 
+// NukeOptions - Settings that change how deletion requests are submitted
+type NukeOptions struct {
+	// Async submits deletion requests without waiting for Cloud Control to complete them
+	Async bool
+	// Run, when set, is the journal that the state of every deletion is recorded in
+	Run *Run
+	// CancelPendingOnInterrupt issues CancelResourceRequest for deletions that are still in flight when the context
+	// passed to NukeAllResources is cancelled
+	CancelPendingOnInterrupt bool
+	// SkipPrepare turns off the preparation steps, such as emptying buckets, that run before a resource is deleted
+	SkipPrepare bool
+	// Verify re-queries every deleted resource once all regions are nuked, to confirm that it is really gone, even if
+	// other deletions failed. It requires Run to be set.
+	Verify bool
+	// BackupPath, when set, is where the model of every resource is backed up to before any of them is deleted
+	BackupPath string
+	// BackupKey, when set, is the AES-256 key the backup archive is encrypted with
+	BackupKey []byte
+	// SnapshotTypes are the resource types, or "all" of SnapshotResourceTypes, that a final snapshot is taken of
+	// before they are deleted. A resource whose snapshot fails is not deleted, but the other resources still are.
+	SnapshotTypes []string
+	// SnapshotRetention, when set, tags final snapshots to be deleted by the first run after it has passed
+	SnapshotRetention time.Duration
+}
+
 type AwsResourceResult struct {
 	TypeName        string
 	Identifier      string
+	PrepareSteps    []PrepareStep
 	Operation       string
 	OperationStatus string
 	StatusMessage   string
 	Error           error
 }
 
-func (a AwsResource) Nuke(config aws.Config, identifiers []string) (pterm.TableData, error) {
-	svc := cloudcontrol.NewFromConfig(config)
+func (a AwsResource) Nuke(ctx context.Context, config aws.Config, identifiers []string, region string, opts NukeOptions) (pterm.TableData, error) {
+	svc := clientsFrom(ctx).CloudControl(config)
 
 	tableData := make([][]string, 1)
 
@@ -151,9 +183,17 @@ func (a AwsResource) Nuke(config aws.Config, identifiers []string) (pterm.TableD
 	wg := new(sync.WaitGroup)
 	wg.Add(len(identifiers))
 	resultChans := make([]chan AwsResourceResult, len(identifiers))
+	handler, hasHandler := resourceHandlerFor(a.TypeName)
 	for i, identifier := range identifiers {
 		resultChans[i] = make(chan AwsResourceResult, 1)
-		go nukeAsync(wg, resultChans[i], svc, a.TypeName, identifier)
+		if hasHandler {
+			go func(resultChan chan AwsResourceResult, identifier string) {
+				defer wg.Done()
+				resultChan <- nukeWithHandler(ctx, handler, config, identifier, region, opts)
+			}(resultChans[i], identifier)
+			continue
+		}
+		go nukeAsync(ctx, wg, resultChans[i], config, svc, a.TypeName, identifier, region, opts)
 	}
 	wg.Wait()
 
@@ -169,6 +209,20 @@ func (a AwsResource) Nuke(config aws.Config, identifiers []string) (pterm.TableD
 
 	// Display results table
 	for identifier, result := range resultsMap {
+		for _, step := range result.PrepareSteps {
+			stepStatus, stepErr := "SUCCESS", "nil"
+			if step.Error != nil {
+				stepStatus, stepErr = "FAILED", step.Error.Error()
+			}
+			tableData = append(tableData, []string{
+				colorTypeAndIdentifier(result.TypeName, identifier),
+				"PREPARE",
+				colorOperationStatus(stepStatus),
+				truncateText(step.Description, 60),
+				stepErr,
+			})
+		}
+
 		var errResult string
 		if result.Error != nil {
 			errResult = result.Error.Error()
@@ -203,7 +257,13 @@ func colorOperationStatus(s string) string {
 	return pterm.Red(s)
 }
 
-func nukeAsync(wg *sync.WaitGroup, resultChan chan AwsResourceResult, svc *cloudcontrol.Client, typeName, identifier string) {
+// detachedContext returns a context that is not cancelled when cloud-nuke is interrupted, used to record the final
+// state of requests that were already submitted.
+func detachedContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), 30*time.Second)
+}
+
+func nukeAsync(ctx context.Context, wg *sync.WaitGroup, resultChan chan AwsResourceResult, config aws.Config, svc CloudControlAPI, typeName, identifier, region string, opts NukeOptions) {
 	defer wg.Done()
 
 	awsResourceResult := AwsResourceResult{
@@ -212,22 +272,96 @@ func nukeAsync(wg *sync.WaitGroup, resultChan chan AwsResourceResult, svc *cloud
 		Error:      nil,
 	}
 
+	if ctx.Err() != nil {
+		// The resource stays planned in the journal, so a resumed run picks it up
+		awsResourceResult.OperationStatus = "Skipped"
+		awsResourceResult.StatusMessage = "Interrupted before the deletion was submitted"
+
+		resultChan <- awsResourceResult
+		return
+	}
+
+	// The snapshot is taken before preparing, so that a resource that couldn't be snapshotted is left untouched
+	if snapshotStep := takeFinalSnapshot(ctx, config, typeName, identifier, opts); snapshotStep != nil {
+		awsResourceResult.PrepareSteps = append(awsResourceResult.PrepareSteps, *snapshotStep)
+		if snapshotStep.Error != nil {
+			snapshotErr := PrepareFailedError{Step: snapshotStep.Description, Underlying: snapshotStep.Error}
+			awsResourceResult.OperationStatus = "Skipped"
+			awsResourceResult.StatusMessage = "Final snapshot failed"
+			awsResourceResult.Error = snapshotErr
+			opts.Run.record(region, typeName, identifier, func(entry *RunEntry) {
+				entry.fail(snapshotErr)
+			})
+
+			resultChan <- awsResourceResult
+			return
+		}
+	}
+
+	if !opts.SkipPrepare {
+		prepareSteps, prepareErr := prepareResource(ctx, config, svc, typeName, identifier)
+		awsResourceResult.PrepareSteps = append(awsResourceResult.PrepareSteps, prepareSteps...)
+		if prepareErr != nil {
+			// Deleting the resource would fail anyway, so don't submit the deletion
+			awsResourceResult.OperationStatus = "Skipped"
+			awsResourceResult.StatusMessage = "Preparation for deletion failed"
+			awsResourceResult.Error = prepareErr
+			opts.Run.record(region, typeName, identifier, func(entry *RunEntry) {
+				entry.fail(prepareErr)
+			})
+
+			resultChan <- awsResourceResult
+			return
+		}
+	}
+
 	logging.Logger.Infof("Nuking resource type: %s with identifier: %s", typeName, identifier)
 
 	deleteInput := &cloudcontrol.DeleteResourceInput{
-		TypeName:   aws.String(typeName),
-		Identifier: aws.String(identifier),
+		TypeName:    aws.String(typeName),
+		Identifier:  aws.String(identifier),
+		ClientToken: aws.String(opts.Run.ClientToken(region, typeName, identifier)),
 	}
 
-	deleteOutput, deleteErr := svc.DeleteResource(context.Background(), deleteInput)
+	deleteOutput, deleteErr := submitDeletion(ctx, svc, deleteInput)
 	if deleteErr != nil {
 		awsResourceResult.Error = deleteErr
+		opts.Run.record(region, typeName, identifier, func(entry *RunEntry) {
+			entry.fail(deleteErr)
+		})
+
+		resultChan <- awsResourceResult
+		return
+	}
+
+	if deleteOutput == nil {
+		// Already gone, for instance deleted by an earlier attempt whose outcome was never journaled
+		awsResourceResult.Operation = string(types.OperationDelete)
+		awsResourceResult.OperationStatus = string(types.OperationStatusSuccess)
+		awsResourceResult.StatusMessage = "Resource was already deleted"
+		opts.Run.record(region, typeName, identifier, func(entry *RunEntry) {
+			entry.State = RunEntrySucceeded
+			entry.OperationStatus = string(types.OperationStatusSuccess)
+		})
 
 		resultChan <- awsResourceResult
 		return
 	}
 
 	requestToken := deleteOutput.ProgressEvent.RequestToken
+	opts.Run.record(region, typeName, identifier, func(entry *RunEntry) {
+		entry.update(deleteOutput.ProgressEvent)
+	})
+
+	if opts.Async {
+		// Don't wait on the deletion: its outcome is checked later with `cloud-nuke status`
+		awsResourceResult.Operation = string(deleteOutput.ProgressEvent.Operation)
+		awsResourceResult.OperationStatus = string(deleteOutput.ProgressEvent.OperationStatus)
+		awsResourceResult.StatusMessage = "Submitted: check progress with cloud-nuke status"
+
+		resultChan <- awsResourceResult
+		return
+	}
 
 	waiter := cloudcontrol.NewResourceRequestSuccessWaiter(svc, func(o *cloudcontrol.ResourceRequestSuccessWaiterOptions) {
 		o.Retryable = RetryGetResourceRequestStatus(nil)
@@ -242,14 +376,24 @@ func nukeAsync(wg *sync.WaitGroup, resultChan chan AwsResourceResult, svc *cloud
 
 	logging.Logger.Debugf("Waiting on deletion of resource type: %s with identifier: %s", typeName, identifier)
 
-	_, waitErr := waiter.WaitForOutput(context.TODO(), waitParams, maxWaitDur)
+	_, waitErr := waiter.WaitForOutput(ctx, waitParams, maxWaitDur)
 	if waitErr != nil {
-		fmt.Errorf("Error waiting on output: %+v\n", waitErr)
+		logging.Logger.Debugf("Error waiting on output: %+v\n", waitErr)
 	}
 
-	statusOutput, getStatusErr := svc.GetResourceRequestStatus(context.TODO(), waitParams)
+	statusCtx, cancelStatus := detachedContext()
+	defer cancelStatus()
+
+	if ctx.Err() != nil && opts.CancelPendingOnInterrupt {
+		cancelResourceRequest(statusCtx, svc, typeName, identifier, requestToken)
+	}
+
+	statusOutput, getStatusErr := svc.GetResourceRequestStatus(statusCtx, waitParams)
 
 	if statusOutput != nil {
+		opts.Run.record(region, typeName, identifier, func(entry *RunEntry) {
+			entry.update(statusOutput.ProgressEvent)
+		})
 
 		logging.Logger.Debugf("DEBUG: statusOutput: %+v\n", statusOutput)
 		logging.Logger.Debugf("DEBUG: statusOutput.ProgressEvent: %+v\n", statusOutput.ProgressEvent)
@@ -268,6 +412,19 @@ func nukeAsync(wg *sync.WaitGroup, resultChan chan AwsResourceResult, svc *cloud
 	resultChan <- awsResourceResult
 }
 
+// cancelResourceRequest asks Cloud Control to cancel a deletion that is still in flight. Requests that already
+// reached a terminal state can't be cancelled, so failures are only logged.
+func cancelResourceRequest(ctx context.Context, svc CloudControlAPI, typeName, identifier string, requestToken *string) {
+	logging.Logger.Infof("Cancelling pending deletion of resource type: %s with identifier: %s", typeName, identifier)
+
+	_, err := svc.CancelResourceRequest(ctx, &cloudcontrol.CancelResourceRequestInput{
+		RequestToken: requestToken,
+	})
+	if err != nil {
+		logging.Logger.Warnf("Could not cancel deletion of resource type: %s with identifier: %s: %s", typeName, identifier, err)
+	}
+}
+
 func RetryGetResourceRequestStatus(pProgressEvent **types.ProgressEvent) func(context.Context, *cloudcontrol.GetResourceRequestStatusInput, *cloudcontrol.GetResourceRequestStatusOutput, error) (bool, error) {
 	return func(ctx context.Context, input *cloudcontrol.GetResourceRequestStatusInput, output *cloudcontrol.GetResourceRequestStatusOutput, err error) (bool, error) {
 		if err == nil {
@@ -301,12 +458,6 @@ func truncateText(s string, max int) string {
 	return s[:max]
 }
 
-type AwsResources interface {
-	TypeName() string
-	ResourceIdentifiers() []string
-	Nuke(config aws.Config, identifiers []string) error
-}
-
 type AwsRegionResource struct {
 	Resources []*AwsResource
 }
@@ -322,6 +473,11 @@ type Query struct {
 
 // NewQuery configures and returns a Query struct that can be passed into the InspectResources method
 func NewQuery(regions, excludeRegions, resourceTypes, excludeResourceTypes []string, excludeAfter time.Time) (*Query, error) {
+	return NewQueryWithContext(context.Background(), regions, excludeRegions, resourceTypes, excludeResourceTypes, excludeAfter)
+}
+
+// NewQueryWithContext - NewQuery, validated with the clients of ctx
+func NewQueryWithContext(ctx context.Context, regions, excludeRegions, resourceTypes, excludeResourceTypes []string, excludeAfter time.Time) (*Query, error) {
 	q := &Query{
 		Regions:              regions,
 		ExcludeRegions:       excludeRegions,
@@ -330,7 +486,7 @@ func NewQuery(regions, excludeRegions, resourceTypes, excludeResourceTypes []str
 		ExcludeAfter:         excludeAfter,
 	}
 
-	validationErr := q.Validate()
+	validationErr := q.ValidateWithContext(ctx)
 
 	if validationErr != nil {
 		return q, validationErr
@@ -342,16 +498,22 @@ func NewQuery(regions, excludeRegions, resourceTypes, excludeResourceTypes []str
 // Validate ensures the configured values for a Query are valid, returning an error if there are
 // any invalid params, or nil if the Query is valid
 func (q *Query) Validate() error {
-	resourceTypes, err := HandleResourceTypeSelections(q.ResourceTypes, q.ExcludeResourceTypes)
+	return q.ValidateWithContext(context.Background())
+}
+
+// ValidateWithContext - Validate, with the clients of ctx
+func (q *Query) ValidateWithContext(ctx context.Context) error {
+	resourceTypes, err := HandleResourceTypeSelections(ctx, q.ResourceTypes, q.ExcludeResourceTypes)
 	if err != nil {
 		return err
 	}
 
 	q.ResourceTypes = resourceTypes
 
-	regions, err := GetEnabledRegions()
+	// GetEnabledRegionsWithContext already returns a CouldNotDetermineEnabledRegionsError
+	regions, err := GetEnabledRegionsWithContext(ctx)
 	if err != nil {
-		return CouldNotDetermineEnabledRegionsError{Underlying: err}
+		return err
 	}
 
 	// global is a fake region, used to represent global resources
@@ -424,6 +586,186 @@ func (err CouldNotSelectRegionError) Error() string {
 	return fmt.Sprintf("Unable to determine target region set. Please double check your combination of target and excluded regions. Original error: %v", err.Underlying)
 }
 
+type InvalidRunFileError struct {
+	Path       string
+	Underlying error
+}
+
+func (err InvalidRunFileError) Error() string {
+	return fmt.Sprintf("Could not parse run file %s. Original error: %v", err.Path, err.Underlying)
+}
+
+type FailedDeletionRequestsError struct {
+	Count int
+}
+
+func (err FailedDeletionRequestsError) Error() string {
+	return fmt.Sprintf("%d deletion requests did not complete successfully", err.Count)
+}
+
+type PrepareFailedError struct {
+	Step       string
+	Underlying error
+}
+
+func (err PrepareFailedError) Error() string {
+	return fmt.Sprintf("Could not prepare resource for deletion (%s). Original error: %v", err.Step, err.Underlying)
+}
+
+type ResourcesStillPresentError struct {
+	Count int
+}
+
+func (err ResourcesStillPresentError) Error() string {
+	return fmt.Sprintf("%d resources are still present after their deletion succeeded", err.Count)
+}
+
+type InvalidBackupError struct {
+	Path   string
+	Reason string
+}
+
+func (err InvalidBackupError) Error() string {
+	return fmt.Sprintf("Could not read backup archive %s: %s", err.Path, err.Reason)
+}
+
+type InvalidBackupKeyError struct {
+	Path string
+}
+
+func (err InvalidBackupKeyError) Error() string {
+	return fmt.Sprintf("Backup key file %s must contain a 32 byte key, either raw or hex encoded", err.Path)
+}
+
+type RestoreFailedError struct {
+	Count int
+}
+
+func (err RestoreFailedError) Error() string {
+	return fmt.Sprintf("%d resources could not be restored", err.Count)
+}
+
+type BackupAccountMismatchError struct {
+	BackupAccountID string
+	AccountID       string
+}
+
+func (err BackupAccountMismatchError) Error() string {
+	if err.BackupAccountID == "" {
+		return fmt.Sprintf("The backup doesn't record the account it was taken in, so it can't be checked against account %s. Pass --allow-account-mismatch to restore it anyway.", err.AccountID)
+	}
+	return fmt.Sprintf("The backup was taken in account %s, but the credentials belong to account %s. Pass --allow-account-mismatch to restore it anyway.", err.BackupAccountID, err.AccountID)
+}
+
+type UnrestorableResourceTypeError struct {
+	TypeName string
+}
+
+func (err UnrestorableResourceTypeError) Error() string {
+	return fmt.Sprintf("Resource type %s is handled by a plugin, and Cloud Control can't create it", err.TypeName)
+}
+
+type QuarantineFailedError struct {
+	Count int
+}
+
+func (err QuarantineFailedError) Error() string {
+	return fmt.Sprintf("%d resources could not be marked for deletion", err.Count)
+}
+
+type TagsNotSupportedError struct {
+	TypeName string
+}
+
+func (err TagsNotSupportedError) Error() string {
+	return fmt.Sprintf("resource type %s does not support tags, so its resources can't be marked for deletion", err.TypeName)
+}
+
+type InvalidNukePlanError struct {
+	Path   string
+	Reason string
+}
+
+func (err InvalidNukePlanError) Error() string {
+	return fmt.Sprintf("Could not read plan file %s: %s", err.Path, err.Reason)
+}
+
+type StaleNukePlanError struct {
+	Reason string
+}
+
+func (err StaleNukePlanError) Error() string {
+	return fmt.Sprintf("refusing to apply a stale plan: %s. Make a new plan with: cloud-nuke plan", err.Reason)
+}
+
+type RootCredentialsError struct {
+	CallerArn string
+}
+
+func (err RootCredentialsError) Error() string {
+	return fmt.Sprintf("refusing to run with the root credentials of the account (%s). Use the credentials of an IAM user or role instead", err.CallerArn)
+}
+
+type AccountNotAllowedError struct {
+	AccountID string
+	Reason    string
+}
+
+func (err AccountNotAllowedError) Error() string {
+	return fmt.Sprintf("refusing to run against account %s: %s", err.AccountID, err.Reason)
+}
+
+type OrgAccountsFailedError struct {
+	Count int
+}
+
+func (err OrgAccountsFailedError) Error() string {
+	return fmt.Sprintf("the run failed in %d accounts", err.Count)
+}
+
+type NoMatchingProfilesError struct {
+	Glob string
+}
+
+func (err NoMatchingProfilesError) Error() string {
+	return fmt.Sprintf("no profile of the shared AWS config matches %q", err.Glob)
+}
+
+type NukeInterruptedError struct{}
+
+func (err NukeInterruptedError) Error() string {
+	return "cloud-nuke was interrupted before all resources were nuked"
+}
+
+type PluginError struct {
+	Path    string
+	Method  string
+	Message string
+}
+
+func (err PluginError) Error() string {
+	return fmt.Sprintf("Plugin %s failed on %s: %s", err.Path, err.Method, err.Message)
+}
+
+// DuplicateResourceTypeError is returned when a plugin declares a resource type that already has a handler
+type DuplicateResourceTypeError struct {
+	TypeName     string
+	PluginPath   string
+	RegisteredBy string
+}
+
+func (err DuplicateResourceTypeError) Error() string {
+	return fmt.Sprintf("Plugin %s declares resource type %s, which is already handled by %s. Remove one of them, or give the plugin's type another name.", err.PluginPath, err.TypeName, err.RegisteredBy)
+}
+
+type ResourceNotDescribableError struct {
+	TypeName string
+}
+
+func (err ResourceNotDescribableError) Error() string {
+	return fmt.Sprintf("The handler for resource type %s can't describe resources", err.TypeName)
+}
+
 type CouldNotDetermineEnabledRegionsError struct {
 	Underlying error
 }
@@ -431,3 +773,11 @@ type CouldNotDetermineEnabledRegionsError struct {
 func (err CouldNotDetermineEnabledRegionsError) Error() string {
 	return fmt.Sprintf("Unable to determine enabled regions in target account. Original error: %v", err.Underlying)
 }
+
+type UnknownPartitionError struct {
+	Value string
+}
+
+func (err UnknownPartitionError) Error() string {
+	return fmt.Sprintf("%s is not in a known AWS partition", err.Value)
+}
@@ -1,9 +1,19 @@
 package aws
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cloudformation_types "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2_types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	mock_aws "github.com/gruntwork-io/cloud-nuke/aws/mocks/clients"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -22,22 +32,75 @@ func TestNewQueryAcceptsValidExcludeAfterEntries(t *testing.T) {
 			Name:           "Can pass time.Now plus 24 hours",
 			Regions:        []string{"us-east-1"},
 			ExcludeRegions: []string{},
-			ResourceTypes:  []string{"ec2"},
+			ResourceTypes:  []string{"AWS::EC2::Instance"},
 			ExcludeAfter:   time.Now().Add(time.Hour * 24),
 		},
 		{
 			Name:           "Can pass time.Now",
 			Regions:        []string{"us-east-1"},
 			ExcludeRegions: []string{},
-			ResourceTypes:  []string{"ec2"},
+			ResourceTypes:  []string{"AWS::EC2::Instance"},
 			ExcludeAfter:   time.Now(),
 		},
 	}
 
+	ctrl := gomock.NewController(t)
+	mockCloudFormation := mock_aws.NewMockCloudFormationAPI(ctrl)
+	mockCloudFormation.EXPECT().
+		ListTypes(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&cloudformation.ListTypesOutput{
+			TypeSummaries: []cloudformation_types.TypeSummary{{TypeName: aws.String("AWS::EC2::Instance")}},
+		}, nil).
+		AnyTimes()
+	mockEC2 := mock_aws.NewMockEC2API(ctrl)
+	mockEC2.EXPECT().
+		DescribeRegions(gomock.Any(), gomock.Any()).
+		Return(&ec2.DescribeRegionsOutput{Regions: []ec2_types.Region{
+			{RegionName: aws.String("us-east-1"), OptInStatus: aws.String(RegionOptInNotRequired)},
+		}}, nil).
+		AnyTimes()
+	ctx := WithPartition(WithClientFactory(context.Background(), StaticClientFactory{CloudFormationClient: mockCloudFormation, EC2Client: mockEC2}), mustPartition("aws"))
+
 	for _, tc := range testCases {
 		t.Run(tc.Name, func(t *testing.T) {
-			_, err := NewQuery(tc.Regions, tc.ExcludeRegions, tc.ResourceTypes, tc.ExcludeResourceTypes, tc.ExcludeAfter)
+			_, err := NewQueryWithContext(ctx, tc.Regions, tc.ExcludeRegions, tc.ResourceTypes, tc.ExcludeResourceTypes, tc.ExcludeAfter)
 			require.NoError(t, err)
 		})
 	}
 }
+
+func TestNewQueryWithContextUsesItsClients(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockCloudFormation := mock_aws.NewMockCloudFormationAPI(ctrl)
+	mockCloudFormation.EXPECT().
+		ListTypes(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&cloudformation.ListTypesOutput{
+			TypeSummaries: []cloudformation_types.TypeSummary{{TypeName: aws.String("AWS::Logs::LogGroup")}},
+		}, nil).
+		AnyTimes()
+	mockEC2 := mock_aws.NewMockEC2API(ctrl)
+	mockEC2.EXPECT().
+		DescribeRegions(gomock.Any(), gomock.Any()).
+		Return(&ec2.DescribeRegionsOutput{Regions: []ec2_types.Region{
+			{RegionName: aws.String("us-east-1"), OptInStatus: aws.String(RegionOptInNotRequired)},
+			{RegionName: aws.String("eu-west-1"), OptInStatus: aws.String(RegionOptInNotRequired)},
+		}}, nil).
+		Times(1)
+	ctx := WithPartition(WithClientFactory(context.Background(), StaticClientFactory{CloudFormationClient: mockCloudFormation, EC2Client: mockEC2}), mustPartition("aws"))
+
+	q, err := NewQueryWithContext(ctx, nil, []string{"us-east-1"}, []string{"AWS::Logs::LogGroup"}, nil, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"AWS::Logs::LogGroup"}, q.ResourceTypes)
+	assert.Equal(t, []string{"eu-west-1", GlobalRegion}, q.Regions)
+
+	mockEC2.EXPECT().
+		DescribeRegions(gomock.Any(), gomock.Any()).
+		Return(nil, assert.AnError).
+		Times(1)
+	_, err = NewQueryWithContext(ctx, nil, nil, []string{"AWS::Logs::LogGroup"}, nil, time.Now())
+	regionsErr, ok := errors.Unwrap(err).(CouldNotDetermineEnabledRegionsError)
+	require.True(t, ok, err)
+	assert.Equal(t, assert.AnError, regionsErr.Underlying)
+}
@@ -0,0 +1,181 @@
+package aws
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/go-commons/collections"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/pterm/pterm"
+)
+
+// Verification is the outcome of re-checking a resource after its deletion was reported as successful
+type Verification string
+
+const (
+	// VerificationConfirmedGone - the resource can no longer be found
+	VerificationConfirmedGone Verification = "confirmed-gone"
+	// VerificationStillPresent - the resource still exists, even though its deletion was reported as successful
+	VerificationStillPresent Verification = "still-present"
+	// VerificationUnknown - the resource could not be checked
+	VerificationUnknown Verification = "unknown"
+)
+
+// resourceChecker returns whether a resource still exists
+type resourceChecker func(ctx context.Context, typeName, identifier string) (bool, error)
+
+// VerifyRun re-queries every resource whose deletion succeeded in the run, and records whether it is really gone.
+// Resources that are still present are moved back to the failed state, so they are reported, retried by --resume,
+// and make VerifyRun return a ResourcesStillPresentError.
+func VerifyRun(ctx context.Context, run *Run) error {
+	if run == nil {
+		return nil
+	}
+	defer run.flush()
+
+	entriesByRegion := map[string][]*RunEntry{}
+	regions := []string{}
+	for _, entry := range run.entriesInState(RunEntrySucceeded) {
+		if entry.Verification == VerificationConfirmedGone {
+			continue
+		}
+		if _, ok := entriesByRegion[entry.Region]; !ok {
+			regions = append(regions, entry.Region)
+		}
+		entriesByRegion[entry.Region] = append(entriesByRegion[entry.Region], entry)
+	}
+
+	verified := []*RunEntry{}
+	for _, region := range regions {
+		config, err := newConfig(ctx, sessionRegion(ctx, region))
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		entries := entriesByRegion[region]
+		logging.Logger.Infof("Verifying the deletion of %d resources in %s", len(entries), region)
+		if err := verifyEntries(ctx, run, entries, newResourceChecker(ctx, config)); err != nil {
+			return err
+		}
+		verified = append(verified, entries...)
+	}
+
+	renderVerification(verified)
+
+	stillPresent := 0
+	for _, entry := range verified {
+		if entry.Verification == VerificationStillPresent {
+			stillPresent++
+		}
+	}
+	if stillPresent > 0 {
+		return ResourcesStillPresentError{Count: stillPresent}
+	}
+	return nil
+}
+
+// verifyEntries classifies each entry with the checker and journals the outcome
+func verifyEntries(ctx context.Context, run *Run, entries []*RunEntry, check resourceChecker) error {
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return errors.WithStackTrace(ctx.Err())
+		}
+
+		verification := VerificationConfirmedGone
+		present, err := check(ctx, entry.TypeName, entry.Identifier)
+		if err != nil {
+			logging.Logger.Warnf("Could not verify the deletion of %s %s: %s", entry.TypeName, entry.Identifier, err)
+			verification = VerificationUnknown
+		} else if present {
+			verification = VerificationStillPresent
+		}
+
+		if err := run.Transition(entry.Region, entry.TypeName, entry.Identifier, func(entry *RunEntry) {
+			entry.Verification = verification
+			if verification == VerificationStillPresent {
+				entry.markFailed()
+				entry.StatusMessage = "Resource still present after its deletion succeeded"
+			}
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newResourceChecker returns a checker that looks resources up with Cloud Control's GetResource. Types with a
+// registered handler, and types that don't support GetResource, are re-listed instead; each type is listed once.
+func newResourceChecker(ctx context.Context, config aws.Config) resourceChecker {
+	svc := clientsFrom(ctx).CloudControl(config)
+	listed := map[string][]string{}
+
+	relist := func(ctx context.Context, typeName, identifier string) (bool, error) {
+		identifiers, ok := listed[typeName]
+		if !ok {
+			var err error
+			identifiers, err = listResources(ctx, config, svc, typeName)
+			if err != nil {
+				return false, err
+			}
+			listed[typeName] = identifiers
+		}
+		return collections.ListContainsElement(identifiers, identifier), nil
+	}
+
+	return func(ctx context.Context, typeName, identifier string) (bool, error) {
+		if _, ok := resourceHandlerFor(typeName); ok {
+			return relist(ctx, typeName, identifier)
+		}
+
+		_, err := svc.GetResource(ctx, &cloudcontrol.GetResourceInput{
+			TypeName:   aws.String(typeName),
+			Identifier: aws.String(identifier),
+		})
+
+		var notFound *types.ResourceNotFoundException
+		var unsupported *types.UnsupportedActionException
+		switch {
+		case err == nil:
+			return true, nil
+		case stderrors.As(err, &notFound):
+			return false, nil
+		case stderrors.As(err, &unsupported):
+			return relist(ctx, typeName, identifier)
+		default:
+			return false, errors.WithStackTrace(err)
+		}
+	}
+}
+
+func renderVerification(entries []*RunEntry) {
+	if len(entries) == 0 {
+		return
+	}
+
+	tableData := pterm.TableData{{"Region", "Resource", "Verification"}}
+	for _, entry := range entries {
+		verification := string(entry.Verification)
+		switch entry.Verification {
+		case VerificationConfirmedGone:
+			verification = pterm.Green(verification)
+		case VerificationStillPresent:
+			verification = pterm.Red(verification)
+		default:
+			verification = pterm.Yellow(verification)
+		}
+		tableData = append(tableData, []string{entry.Region, colorTypeAndIdentifier(entry.TypeName, entry.Identifier), verification})
+	}
+
+	pterm.Println()
+	renderSection(fmt.Sprintf("Verification of %d deleted resources", len(entries)))
+	pterm.DefaultTable.
+		WithHasHeader().
+		WithData(tableData).
+		Render()
+	pterm.Println()
+}
@@ -0,0 +1,95 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/golang/mock/gomock"
+	mock_aws "github.com/gruntwork-io/cloud-nuke/aws/mocks/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyEntries(t *testing.T) {
+	t.Parallel()
+
+	run := NewRun(filepath.Join(t.TempDir(), "run.json"))
+	for _, identifier := range []string{"gone", "present", "unknown"} {
+		require.NoError(t, run.Transition("us-east-1", "AWS::Logs::LogGroup", identifier, func(entry *RunEntry) {
+			entry.State = RunEntrySucceeded
+		}))
+	}
+
+	check := func(ctx context.Context, typeName, identifier string) (bool, error) {
+		switch identifier {
+		case "present":
+			return true, nil
+		case "unknown":
+			return false, errors.New("AccessDenied")
+		default:
+			return false, nil
+		}
+	}
+	require.NoError(t, verifyEntries(context.Background(), run, run.entriesInState(RunEntrySucceeded), check))
+	require.NoError(t, run.Flush())
+
+	loaded, err := LoadRun(run.Path())
+	require.NoError(t, err)
+	require.Len(t, loaded.Entries, 3)
+
+	assert.Equal(t, VerificationConfirmedGone, loaded.Entries[0].Verification)
+	assert.Equal(t, RunEntrySucceeded, loaded.Entries[0].State)
+
+	assert.Equal(t, VerificationStillPresent, loaded.Entries[1].Verification)
+	assert.Equal(t, RunEntryFailed, loaded.Entries[1].State)
+
+	assert.Equal(t, VerificationUnknown, loaded.Entries[2].Verification)
+	assert.Equal(t, RunEntrySucceeded, loaded.Entries[2].State)
+
+	assert.Len(t, loaded.RemainingEntries(), 1)
+}
+
+func TestNukeAllResourcesVerifiesAfterFailedDeletions(t *testing.T) {
+	t.Parallel()
+
+	lockedType := "Test::Verify::Locked"
+	RegisterPreparer(lockedType, func(ctx context.Context, config aws.Config, svc CloudControlAPI, typeName, identifier string) []PrepareStep {
+		return []PrepareStep{{Description: "Unlock " + identifier, Error: errors.New("AccessDenied")}}
+	})
+
+	ctrl := gomock.NewController(t)
+	mockCloudControl := mock_aws.NewMockCloudControlAPI(ctrl)
+	// The widget is reported as already deleted, but is still found by the verification
+	mockCloudControl.EXPECT().
+		DeleteResource(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, &types.ResourceNotFoundException{})
+	mockCloudControl.EXPECT().
+		GetResource(gomock.Any(), &cloudcontrol.GetResourceInput{TypeName: aws.String(testResourceType), Identifier: aws.String("widget-a")}, gomock.Any()).
+		Return(&cloudcontrol.GetResourceOutput{}, nil)
+
+	account := &AwsAccountResources{
+		Resources: map[string]AwsRegionResource{
+			"us-east-1": {
+				Resources: []*AwsResource{
+					{TypeName: lockedType, Identifiers: []string{"locked-a"}},
+					{TypeName: testResourceType, Identifiers: []string{"widget-a"}},
+				},
+			},
+		},
+	}
+
+	run := NewRun(filepath.Join(t.TempDir(), "run.json"))
+	require.NoError(t, run.Plan(account))
+
+	ctx := WithClientFactory(context.Background(), StaticClientFactory{CloudControlClient: mockCloudControl})
+	err := NukeAllResources(ctx, account, []string{"us-east-1"}, NukeOptions{Run: run, Verify: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Unlock locked-a")
+	assert.Contains(t, err.Error(), ResourcesStillPresentError{Count: 1}.Error())
+	assert.Len(t, run.FailedEntries(), 2)
+}
@@ -1,20 +1,117 @@
 package commands
 
 import (
+	"context"
+	"flag"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/fatih/color"
 	"github.com/gruntwork-io/cloud-nuke/aws"
 	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/externalcreds"
 	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/go-commons/collections"
 	"github.com/gruntwork-io/go-commons/errors"
 	"github.com/gruntwork-io/go-commons/shell"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 )
 
+// orgFlags select the member accounts of an organization that the aws and plan commands run against with --org, or
+// the accounts of the profiles they run against with --profile-glob
+var orgFlags = []cli.Flag{
+	cli.BoolFlag{
+		Name:  "org",
+		Usage: "Run against the member accounts of the organization of the current credentials, instead of against the current account.",
+	},
+	cli.StringSliceFlag{
+		Name:  "org-unit",
+		Usage: "With --org, only run against the accounts in this organizational unit, or in the units nested in it. Include multiple times if more than one.",
+	},
+	cli.StringSliceFlag{
+		Name:  "org-account",
+		Usage: "With --org, only run against this account ID. Include multiple times if more than one.",
+	},
+	cli.StringSliceFlag{
+		Name:  "org-tag",
+		Usage: "With --org, only run against the accounts with this tag, given as key=value. Include multiple times if more than one.",
+	},
+	cli.StringFlag{
+		Name:  "org-role-name",
+		Usage: "With --org, the name of the role to assume in each account.",
+		Value: aws.DefaultOrgRoleName,
+	},
+	cli.IntFlag{
+		Name:  "org-parallelism",
+		Usage: "With --org or --profile-glob, the number of accounts to run against at the same time.",
+		Value: 4,
+	},
+	cli.StringFlag{
+		Name:  "org-report",
+		Usage: "With --org or --profile-glob, write the combined report, keyed by account ID, to this JSON file.",
+	},
+	cli.StringFlag{
+		Name:  "profile-glob",
+		Usage: "Run against the accounts of the profiles of the shared AWS config whose name matches this glob, such as \"sandbox-*\", instead of against the current account. Profiles of the same account are only run against once.",
+	},
+}
+
+// credentialsFlags select the credentials that every command that calls AWS runs with, instead of the default
+// credential chain
+var credentialsFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "profile",
+		Usage: "The profile of the shared AWS config and credentials files to get credentials from.",
+	},
+	cli.StringFlag{
+		Name:  "role-arn",
+		Usage: "The ARN of a role to assume, with the credentials of --profile or of the default credential chain.",
+	},
+	cli.StringFlag{
+		Name:  "external-id",
+		Usage: "With --role-arn, the external ID that the trust policy of the role requires.",
+	},
+	cli.StringFlag{
+		Name:  "role-session-name",
+		Usage: "With --role-arn, the session name of the assumed role.",
+		Value: externalcreds.DefaultRoleSessionName,
+	},
+	cli.StringFlag{
+		Name:  "mfa-serial",
+		Usage: "With --role-arn, the serial number or ARN of the MFA device that assuming the role requires. The token code is asked for once.",
+	},
+	cli.StringFlag{
+		Name:  "session-duration",
+		Usage: "With --role-arn, how long the credentials of the assumed role last. Can be any valid Go duration, such as 15m or 1h. Defaults to 1h.",
+	},
+	cli.StringFlag{
+		Name:  "partition",
+		Usage: "The AWS partition of the credentials, such as aws-cn or aws-us-gov. Detected from the credentials by default.",
+	},
+}
+
+// endpointFlags override the endpoints of the services cloud-nuke calls, for every command that calls AWS
+var endpointFlags = []cli.Flag{
+	cli.StringSliceFlag{
+		Name:   "endpoint",
+		Usage:  "Call this endpoint instead of the AWS one for a service, given as service=URL, such as ec2=http://localhost:4566. The service is one of " + strings.Join(externalcreds.EndpointServices, ", ") + ". Include multiple times if more than one. Overrides the endpoints of the config file.",
+		EnvVar: "CLOUD_NUKE_ENDPOINTS",
+	},
+}
+
+// joinFlags concatenates groups of flags, for commands that share some of their flags with other commands
+func joinFlags(groups ...[]cli.Flag) []cli.Flag {
+	flags := []cli.Flag{}
+	for _, group := range groups {
+		flags = append(flags, group...)
+	}
+	return flags
+}
+
 // CreateCli - Create the CLI app with all commands, flags, and usage text configured.
 func CreateCli(version string) *cli.App {
 	app := cli.NewApp()
@@ -29,7 +126,7 @@ func CreateCli(version string) *cli.App {
 			Name:   "aws",
 			Usage:  "BEWARE: DESTRUCTIVE OPERATION! Nukes AWS resources (ASG, ELB, ELBv2, EBS, EC2, AMI, Snapshots, Elastic IP, RDS, Lambda Function).",
 			Action: errors.WithPanicHandling(awsNuke),
-			Flags: []cli.Flag{
+			Flags: joinFlags([]cli.Flag{
 				cli.StringSliceFlag{
 					Name:  "region",
 					Usage: "Regions to include. Include multiple times if more than one.",
@@ -46,6 +143,10 @@ func CreateCli(version string) *cli.App {
 					Name:  "exclude-resource-type",
 					Usage: "Resource types to exclude from nuking. Include multiple times if more than one.",
 				},
+				cli.StringFlag{
+					Name:  "resource-types-file",
+					Usage: "YAML file, such as nuke-plan.yml, with a ResourcesToNuke list of resource types to nuke in addition to --resource-type.",
+				},
 				cli.BoolFlag{
 					Name:  "list-resource-types",
 					Usage: "List available resource types",
@@ -63,6 +164,20 @@ func CreateCli(version string) *cli.App {
 					Name:  "force",
 					Usage: "Skip nuke confirmation prompt. WARNING: this will automatically delete all resources without any confirmation",
 				},
+				cli.StringSliceFlag{
+					Name:   "allow-account",
+					Usage:  "Only run against this account ID. Include multiple times if more than one. Adds to the accounts allow list of the config file.",
+					EnvVar: "CLOUD_NUKE_ALLOWED_ACCOUNTS",
+				},
+				cli.StringSliceFlag{
+					Name:   "deny-account",
+					Usage:  "Never run against this account ID, such as a production account. Include multiple times if more than one. Adds to the accounts deny list of the config file.",
+					EnvVar: "CLOUD_NUKE_DENIED_ACCOUNTS",
+				},
+				cli.BoolFlag{
+					Name:  "interactive",
+					Usage: "Before confirming, browse and search the listed resources, leave out individual resources or whole types, and view their properties.",
+				},
 				cli.StringFlag{
 					Name:   "log-level",
 					Value:  "info",
@@ -73,8 +188,302 @@ func CreateCli(version string) *cli.App {
 					Name:  "config",
 					Usage: "YAML file specifying matching rules.",
 				},
+				cli.BoolFlag{
+					Name:  "async",
+					Usage: "Submit deletion requests without waiting for them to complete. Request tokens are written to the run file, so outcomes can be checked later with the status command.",
+				},
+				cli.StringFlag{
+					Name:  "run-file",
+					Usage: "Path of the run file that journals the state of every deletion. Defaults to cloud-nuke-run-<run id>.json in the working directory.",
+				},
+				cli.StringFlag{
+					Name:  "resume",
+					Usage: "Resume an interrupted run from its run file: in-flight deletions are reconciled, and only resources that were not deleted yet are nuked, without rescanning.",
+				},
+				cli.StringFlag{
+					Name:  "max-wait",
+					Usage: "Maximum time to wait on each in-flight deletion request when resuming a run. Can be any valid Go duration, such as 10m or 1h.",
+					Value: "10m",
+				},
+				cli.BoolFlag{
+					Name:  "skip-prepare",
+					Usage: "Don't run the preparation steps, such as emptying S3 buckets or turning off deletion protection, that let Cloud Control delete some resource types. Types deleted by their own handler, such as the CloudNuke:: and plugin types, have no preparation steps.",
+				},
+				cli.BoolFlag{
+					Name:  "cancel-pending-on-interrupt",
+					Usage: "When interrupted with SIGINT or SIGTERM, ask Cloud Control to cancel deletions that are still in progress instead of leaving them running.",
+				},
+				cli.BoolFlag{
+					Name:  "verify",
+					Usage: "After nuking, look up every deleted resource again to confirm it is gone. Resources that are still present are reported as failed.",
+				},
+				cli.StringFlag{
+					Name:  "backup",
+					Usage: "Before nuking, write the full model of every resource to this gzip compressed archive. Nothing is nuked if the archive can't be written.",
+				},
+				cli.StringFlag{
+					Name:   "backup-key-file",
+					Usage:  "Encrypt the --backup archive with AES-256-GCM, using the 32 byte key (raw or hex encoded) in this file.",
+					EnvVar: "CLOUD_NUKE_BACKUP_KEY_FILE",
+				},
+				cli.StringSliceFlag{
+					Name:  "snapshot-before-delete",
+					Usage: "Take a final snapshot of resources of this type before deleting them, or of every supported type with 'all'. Include multiple times if more than one. Types deleted by their own handler, such as the CloudNuke:: and plugin types, are never snapshotted.",
+				},
+				cli.IntFlag{
+					Name:  "snapshot-retention-days",
+					Usage: "Tag final snapshots to expire after this many days. Expired snapshots are deleted by any later run that includes the CloudNuke::FinalSnapshot::Expired resource type.",
+				},
+				cli.StringSliceFlag{
+					Name:   "plugin-dir",
+					Usage:  "Directory to load cloud-nuke-plugin-* executables from, which add their own resource types. Include multiple times if more than one.",
+					EnvVar: "CLOUD_NUKE_PLUGIN_DIRS",
+				},
+				cli.StringFlag{
+					Name:  "quarantine",
+					Usage: "Instead of nuking resources right away, tag them to be deleted once this grace period has expired. Later runs with --quarantine only nuke the resources whose grace period has expired. Can be any valid Go duration, such as 72h.",
+				},
+				cli.BoolFlag{
+					Name:  "quarantine-stop",
+					Usage: "With --quarantine, also stop or disable the resources that are marked for deletion, such as EC2 instances, databases and event rules.",
+				},
+			}, orgFlags, credentialsFlags, endpointFlags),
+		},
+		{
+			Name:   "plan",
+			Usage:  "Scans for the resources to nuke, like `aws --dry-run`, and writes them to a plan file that `apply` deletes.",
+			Action: errors.WithPanicHandling(awsPlan),
+			Flags: joinFlags([]cli.Flag{
+				cli.StringFlag{
+					Name:  "output, o",
+					Usage: "Path of the plan file to write.",
+				},
+				cli.StringSliceFlag{
+					Name:  "region",
+					Usage: "Regions to include. Include multiple times if more than one.",
+				},
+				cli.StringSliceFlag{
+					Name:  "exclude-region",
+					Usage: "Regions to exclude. Include multiple times if more than one.",
+				},
+				cli.StringSliceFlag{
+					Name:  "resource-type",
+					Usage: "Resource types to nuke. Include multiple times if more than one.",
+				},
+				cli.StringSliceFlag{
+					Name:  "exclude-resource-type",
+					Usage: "Resource types to exclude from nuking. Include multiple times if more than one.",
+				},
+				cli.StringFlag{
+					Name:  "resource-types-file",
+					Usage: "YAML file, such as nuke-plan.yml, with a ResourcesToNuke list of resource types to nuke in addition to --resource-type.",
+				},
+				cli.StringFlag{
+					Name:  "older-than",
+					Usage: "Only delete resources older than this specified value. Can be any valid Go duration, such as 10m or 8h.",
+					Value: "0s",
+				},
+				cli.StringFlag{
+					Name:  "config",
+					Usage: "YAML file specifying matching rules.",
+				},
+				cli.StringSliceFlag{
+					Name:   "plugin-dir",
+					Usage:  "Directory to load cloud-nuke-plugin-* executables from, which add their own resource types. Include multiple times if more than one.",
+					EnvVar: "CLOUD_NUKE_PLUGIN_DIRS",
+				},
+				cli.StringFlag{
+					Name:   "log-level",
+					Value:  "info",
+					Usage:  "Set log level",
+					EnvVar: "LOG_LEVEL",
+				},
+			}, orgFlags, credentialsFlags, endpointFlags),
+		},
+		{
+			Name:      "apply",
+			Usage:     "BEWARE: DESTRUCTIVE OPERATION! Nukes exactly the resources in a plan file written by `plan`.",
+			ArgsUsage: "<plan file>",
+			Action:    errors.WithPanicHandling(awsApply),
+			Flags: joinFlags([]cli.Flag{
+				cli.StringFlag{
+					Name:  "config",
+					Usage: "YAML file whose accounts rules are enforced and whose endpoints are used. Its matching rules are not used, as the plan already lists the resources to nuke.",
+				},
+				cli.StringFlag{
+					Name:  "max-age",
+					Usage: "Refuse plans made longer ago than this. Can be any valid Go duration, such as 30m or 24h, or 0 to accept plans of any age.",
+					Value: "24h",
+				},
+				cli.BoolFlag{
+					Name:  "dry-run",
+					Usage: "Check the plan and report drift without taking any action.",
+				},
+				cli.BoolFlag{
+					Name:  "force",
+					Usage: "Skip nuke confirmation prompt. WARNING: this will automatically delete all resources in the plan without any confirmation",
+				},
+				cli.StringSliceFlag{
+					Name:   "allow-account",
+					Usage:  "Only run against this account ID. Include multiple times if more than one. Adds to the accounts allow list of the config file.",
+					EnvVar: "CLOUD_NUKE_ALLOWED_ACCOUNTS",
+				},
+				cli.StringSliceFlag{
+					Name:   "deny-account",
+					Usage:  "Never run against this account ID, such as a production account. Include multiple times if more than one. Adds to the accounts deny list of the config file.",
+					EnvVar: "CLOUD_NUKE_DENIED_ACCOUNTS",
+				},
+				cli.BoolFlag{
+					Name:  "interactive",
+					Usage: "Before confirming, browse and search the listed resources, leave out individual resources or whole types, and view their properties.",
+				},
+				cli.BoolFlag{
+					Name:  "async",
+					Usage: "Submit deletion requests without waiting for them to complete. Request tokens are written to the run file, so outcomes can be checked later with the status command.",
+				},
+				cli.StringFlag{
+					Name:  "run-file",
+					Usage: "Path of the run file that journals the state of every deletion. Defaults to cloud-nuke-run-<run id>.json in the working directory.",
+				},
+				cli.BoolFlag{
+					Name:  "skip-prepare",
+					Usage: "Don't run the preparation steps, such as emptying S3 buckets or turning off deletion protection, that let Cloud Control delete some resource types. Types deleted by their own handler, such as the CloudNuke:: and plugin types, have no preparation steps.",
+				},
+				cli.BoolFlag{
+					Name:  "verify",
+					Usage: "After nuking, look up every deleted resource again to confirm it is gone. Resources that are still present are reported as failed.",
+				},
+				cli.BoolFlag{
+					Name:  "cancel-pending-on-interrupt",
+					Usage: "When interrupted with SIGINT or SIGTERM, ask Cloud Control to cancel deletions that are still in progress instead of leaving them running.",
+				},
+				cli.StringFlag{
+					Name:  "backup",
+					Usage: "Before nuking, write the full model of every resource to this gzip compressed archive. Nothing is nuked if the archive can't be written.",
+				},
+				cli.StringFlag{
+					Name:   "backup-key-file",
+					Usage:  "Encrypt the --backup archive with AES-256-GCM, using the 32 byte key (raw or hex encoded) in this file.",
+					EnvVar: "CLOUD_NUKE_BACKUP_KEY_FILE",
+				},
+				cli.StringSliceFlag{
+					Name:  "snapshot-before-delete",
+					Usage: "Take a final snapshot of resources of this type before deleting them, or of every supported type with 'all'. Include multiple times if more than one. Types deleted by their own handler, such as the CloudNuke:: and plugin types, are never snapshotted.",
+				},
+				cli.IntFlag{
+					Name:  "snapshot-retention-days",
+					Usage: "Tag final snapshots to expire after this many days. Expired snapshots are deleted by any later run that includes the CloudNuke::FinalSnapshot::Expired resource type.",
+				},
+				cli.StringSliceFlag{
+					Name:   "plugin-dir",
+					Usage:  "Directory to load cloud-nuke-plugin-* executables from, which add their own resource types. Include multiple times if more than one.",
+					EnvVar: "CLOUD_NUKE_PLUGIN_DIRS",
+				},
+				cli.StringFlag{
+					Name:   "log-level",
+					Value:  "info",
+					Usage:  "Set log level",
+					EnvVar: "LOG_LEVEL",
+				},
+			}, credentialsFlags, endpointFlags),
+		},
+		{
+			Name:      "diff",
+			Usage:     "Reports the resources added, removed or changed between two inventories: plan files written by `plan`, run files or backup archives.",
+			ArgsUsage: "<file a> <file b>",
+			Action:    errors.WithPanicHandling(awsDiff),
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "format",
+					Usage: "Output format: text, json or markdown.",
+					Value: "text",
+				},
+				cli.StringFlag{
+					Name:   "backup-key-file",
+					Usage:  "File holding the key of encrypted backup archives, as passed to --backup-key-file when they were written.",
+					EnvVar: "CLOUD_NUKE_BACKUP_KEY_FILE",
+				},
 			},
 		},
+		{
+			Name:      "status",
+			Usage:     "Reports the outcome of deletion requests submitted by a previous `aws --async` run.",
+			ArgsUsage: "<run file>",
+			Action:    errors.WithPanicHandling(awsStatus),
+			Flags: joinFlags([]cli.Flag{
+				cli.StringFlag{
+					Name:  "config",
+					Usage: "YAML file whose endpoints are used.",
+				},
+				cli.BoolFlag{
+					Name:  "wait",
+					Usage: "Wait for pending deletion requests to complete before reporting.",
+				},
+				cli.BoolFlag{
+					Name:  "verify",
+					Usage: "Look up every resource whose deletion succeeded again to confirm it is gone. Resources that are still present are reported as failed.",
+				},
+				cli.StringFlag{
+					Name:  "max-wait",
+					Usage: "Maximum time to wait on each pending deletion request when --wait is set. Can be any valid Go duration, such as 10m or 1h.",
+					Value: "10m",
+				},
+				cli.StringFlag{
+					Name:   "log-level",
+					Value:  "info",
+					Usage:  "Set log level",
+					EnvVar: "LOG_LEVEL",
+				},
+			}, credentialsFlags, endpointFlags),
+		},
+		{
+			Name:      "restore",
+			Usage:     "Recreates resources from a backup archive written by `aws --backup`.",
+			ArgsUsage: "<archive>",
+			Action:    errors.WithPanicHandling(awsRestore),
+			Flags: joinFlags([]cli.Flag{
+				cli.StringFlag{
+					Name:  "config",
+					Usage: "YAML file whose endpoints are used.",
+				},
+				cli.StringSliceFlag{
+					Name:  "region",
+					Usage: "Only restore resources in this region. Include multiple times if more than one.",
+				},
+				cli.StringSliceFlag{
+					Name:  "resource-type",
+					Usage: "Only restore resources of this type. Include multiple times if more than one.",
+				},
+				cli.StringSliceFlag{
+					Name:  "identifier",
+					Usage: "Only restore the resource with this identifier. Include multiple times if more than one.",
+				},
+				cli.StringFlag{
+					Name:   "backup-key-file",
+					Usage:  "File holding the 32 byte key (raw or hex encoded) the archive was encrypted with.",
+					EnvVar: "CLOUD_NUKE_BACKUP_KEY_FILE",
+				},
+				cli.BoolFlag{
+					Name:  "dry-run",
+					Usage: "Report what would be restored, and what would be missing, without creating anything.",
+				},
+				cli.BoolFlag{
+					Name:  "allow-account-mismatch",
+					Usage: "Restore the backup even if it was taken in another account than the one of the credentials.",
+				},
+				cli.StringFlag{
+					Name:  "max-wait",
+					Usage: "Maximum time to wait on the creation of each resource. Can be any valid Go duration, such as 10m or 1h.",
+					Value: "30m",
+				},
+				cli.StringFlag{
+					Name:   "log-level",
+					Value:  "info",
+					Usage:  "Set log level",
+					EnvVar: "LOG_LEVEL",
+				},
+			}, credentialsFlags, endpointFlags),
+		},
 	}
 
 	return app
@@ -93,7 +502,7 @@ func parseDurationParam(paramValue string) (*time.Time, error) {
 	return &excludeAfter, nil
 }
 
-func awsNuke(c *cli.Context) error {
+func setLogLevel(c *cli.Context) error {
 	logLevel := c.String("log-level")
 
 	parsedLogLevel, err := logrus.ParseLevel(logLevel)
@@ -102,126 +511,427 @@ func awsNuke(c *cli.Context) error {
 	}
 	logging.Logger.Level = parsedLogLevel
 
-	configObj := config.Config{}
-	configFilePath := c.String("config")
+	return nil
+}
 
-	if configFilePath != "" {
-		configObjPtr, err := config.GetConfig(configFilePath)
-		if err != nil {
-			return fmt.Errorf("Error reading config - %s - %s", configFilePath, err)
-		}
-		configObj = *configObjPtr
+func awsNuke(c *cli.Context) error {
+	if err := setLogLevel(c); err != nil {
+		return err
 	}
 
-	if c.Bool("list-resource-types") {
-		for _, resourceType := range aws.ListResourceTypes() {
-			fmt.Println(resourceType)
-		}
-		return nil
-	}
+	ctx, cancel := interruptibleContext()
+	defer cancel()
 
-	// Ensure that the resourceTypes and excludeResourceTypes arguments are valid, and then filter
-	// resourceTypes
-	resourceTypes, err := aws.HandleResourceTypeSelections(c.StringSlice("resource-type"), c.StringSlice("exclude-resource-type"))
+	configObj, err := loadConfig(c)
 	if err != nil {
 		return err
 	}
 
-	// Log which resource types will be nuked
-	logging.Logger.Info("The following resource types will be nuked:")
-	for _, resourceType := range resourceTypes {
-		logging.Logger.Infof("- %s", resourceType)
+	ctx, err = configureClients(ctx, c, configObj)
+	if err != nil {
+		return err
 	}
 
-	regions, err := aws.GetEnabledRegions()
-	if err != nil {
-		return errors.WithStackTrace(err)
+	// Plugins register their resource types, so they must be loaded before resource types are listed or validated
+	if err := aws.LoadPlugins(ctx, c.StringSlice("plugin-dir")); err != nil {
+		return err
 	}
 
-	// global is a fake region, used to represent global resources
-	regions = append(regions, aws.GlobalRegion)
+	if c.Bool("list-resource-types") {
+		for _, resourceType := range aws.ListResourceTypesWithContext(ctx) {
+			fmt.Println(resourceType)
+		}
+		return nil
+	}
 
-	selectedRegions := c.StringSlice("region")
-	excludedRegions := c.StringSlice("exclude-region")
+	return nukeAccount(ctx, c, configObj)
+}
 
-	// targetRegions uses selectedRegions and excludedRegions to create a final
-	// target region slice.
-	targetRegions, err := aws.GetTargetRegions(regions, selectedRegions, excludedRegions)
-	if err != nil {
-		return fmt.Errorf("Failed to select regions: %s", err)
+// RunAws runs `cloud-nuke aws` with args against the clients and partition of ctx, which are used as they are: unlike
+// the command, it doesn't configure the clients from the flags, load plugins or change the log level. The scenario
+// tests of the fake cloud use it, so that they go through the same checks, scan and nuke as the command.
+func RunAws(ctx context.Context, args []string) error {
+	var flags []cli.Flag
+	for _, command := range CreateCli("").Commands {
+		if command.Name == "aws" {
+			flags = command.Flags
+		}
 	}
 
-	excludeAfter, err := parseDurationParam(c.String("older-than"))
-	if err != nil {
-		return errors.WithStackTrace(err)
+	set := flag.NewFlagSet("aws", flag.ContinueOnError)
+	set.SetOutput(ioutil.Discard)
+	for _, f := range flags {
+		f.Apply(set)
 	}
-
-	logging.Logger.Infof("Retrieving active AWS resources in [%s]", strings.Join(targetRegions[:], ", "))
-	account, err := aws.GetAllResources(targetRegions, *excludeAfter, resourceTypes, configObj)
-	if err != nil {
+	if err := set.Parse(args); err != nil {
 		return errors.WithStackTrace(err)
 	}
+	c := cli.NewContext(nil, set, nil)
 
-	if len(account.Resources) == 0 {
-		logging.Logger.Infoln("Nothing to nuke, you're all good!")
-		return nil
+	configObj, err := loadConfig(c)
+	if err != nil {
+		return err
 	}
+	return nukeAccount(ctx, c, configObj)
+}
 
-	nukableResources := aws.ExtractResourcesForPrinting(account)
-
-	logging.Logger.Infof("The following %d AWS resources will be nuked:", len(nukableResources))
-
-	for _, resource := range nukableResources {
-		logging.Logger.Infoln(resource)
+// nukeAccount runs the steps of `cloud-nuke aws` that follow the configuration of the clients: it checks the flags and
+// the account, scans or resumes, and nukes what it found once confirmed
+func nukeAccount(ctx context.Context, c *cli.Context, configObj config.Config) error {
+	nukeOpts, err := nukeOptions(c)
+	if err != nil {
+		return err
 	}
 
-	if c.Bool("dry-run") {
-		logging.Logger.Infoln("Not taking any action as dry-run set to true.")
-		return nil
+	if c.Bool("interactive") && c.Bool("force") {
+		return InvalidFlagError{Name: "interactive", Value: "true"}
 	}
 
-	if !c.Bool("force") {
-		prompt := "\nAre you sure you want to nuke all listed resources? Enter 'nuke' to confirm (or exit with ^C): "
-		proceed, err := confirmationPrompt(prompt, 2)
+	// With --profile-glob, the account of each profile is checked instead of the current credentials
+	var identity *aws.AccountIdentity
+	if c.String("profile-glob") == "" {
+		identity, err = checkAccount(ctx, c, configObj)
 		if err != nil {
 			return err
 		}
-		if proceed {
-			if err := aws.NukeAllResources(account, regions); err != nil {
-				return err
-			}
+	}
+
+	var gracePeriod time.Duration
+	if value := c.String("quarantine"); value != "" {
+		var err error
+		gracePeriod, err = time.ParseDuration(value)
+		if err != nil || gracePeriod <= 0 || c.String("resume") != "" {
+			return InvalidFlagError{Name: "quarantine", Value: value}
 		}
-	} else {
-		logging.Logger.Infoln("The --force flag is set, so waiting for 10 seconds before proceeding to nuke everything in your account. If you don't want to proceed, hit CTRL+C now!!")
-		for i := 10; i > 0; i-- {
-			fmt.Printf("%d...", i)
-			time.Sleep(1 * time.Second)
+	} else if c.Bool("quarantine-stop") {
+		return InvalidFlagError{Name: "quarantine-stop", Value: "true"}
+	}
+
+	if flag := multiAccountFlag(c); flag != "" {
+		if gracePeriod > 0 || c.String("resume") != "" || c.Bool("interactive") {
+			return InvalidFlagError{Name: flag, Value: c.String(flag)}
 		}
 
-		fmt.Println()
-		if err := aws.NukeAllResources(account, regions); err != nil {
-			return err
+		scope := "the organization of " + describeTarget(identity)
+		if glob := c.String("profile-glob"); glob != "" {
+			scope = fmt.Sprintf("the profiles matching %q", glob)
 		}
+		return awsNukeAccounts(ctx, c, configObj, nukeOpts, scope)
 	}
 
-	return nil
-}
+	var account *aws.AwsAccountResources
+	var regions []string
 
-func confirmationPrompt(prompt string, maxPrompts int) (bool, error) {
-	color := color.New(color.FgHiRed, color.Bold)
-	color.Println("\nTHE NEXT STEPS ARE DESTRUCTIVE AND COMPLETELY IRREVERSIBLE, PROCEED WITH CAUTION!!!")
+	if resumePath := c.String("resume"); resumePath != "" {
+		run, err := aws.LoadRun(resumePath)
+		if err != nil {
+			return err
+		}
+
+		maxWait, err := time.ParseDuration(c.String("max-wait"))
+		if err != nil {
+			return InvalidFlagError{Name: "max-wait", Value: c.String("max-wait")}
+		}
+
+		// Resuming skips the scan: the resources left to delete are the ones recorded in the journal
+		account, err = aws.ResumeRun(ctx, run, maxWait)
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		regions = run.Regions()
+		nukeOpts.Run = run
+	} else {
+		var err error
+		account, regions, err = scanResources(ctx, c, configObj)
+		if err != nil {
+			return err
+		}
+		nukeOpts.Run = aws.NewRun(c.String("run-file"))
+	}
+
+	if len(account.Resources) == 0 {
+		logging.Logger.Infoln("Nothing to nuke, you're all good!")
+		return nil
+	}
+
+	// In quarantine mode, only the resources whose grace period has expired are nuked, and the others are marked
+	var quarantine *aws.QuarantinePlan
+	if gracePeriod > 0 {
+		var err error
+		quarantine, err = aws.PlanQuarantine(ctx, account, time.Now())
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		aws.RenderQuarantinePlan(quarantine)
+
+		account = quarantine.Due()
+		toMark := len(quarantine.EntriesWithAction(aws.QuarantineMark))
+		if toMark == 0 && len(account.Resources) == 0 {
+			logging.Logger.Infoln("Nothing to mark or nuke, you're all good!")
+			return nil
+		}
+		logging.Logger.Infof("%d AWS resources will be marked for deletion in %s", toMark, gracePeriod)
+	}
+
+	nukableResources := aws.ExtractResourcesForPrinting(account)
+
+	logging.Logger.Infof("The following %d AWS resources will be nuked:", len(nukableResources))
+
+	for _, resource := range nukableResources {
+		logging.Logger.Infoln(resource)
+	}
+
+	if c.Bool("dry-run") {
+		logging.Logger.Infoln("Not taking any action as dry-run set to true.")
+		return nil
+	}
+
+	account, proceed, err := pickAndConfirm(ctx, c, account, identity)
+	if err != nil || !proceed {
+		return err
+	}
+
+	var markErr error
+	if quarantine != nil {
+		markErr = aws.MarkForDeletion(ctx, quarantine, gracePeriod, c.Bool("quarantine-stop"), time.Now())
+		aws.RenderQuarantinePlan(quarantine)
+		if len(account.Resources) == 0 {
+			return markErr
+		}
+	}
+
+	if err := nukeAllResources(ctx, account, regions, nukeOpts); err != nil {
+		return err
+	}
+	return markErr
+}
+
+// pickAndConfirm lets the user pick the resources to nuke when --interactive is set, then asks them to confirm
+// nuking the resources that are still selected
+func pickAndConfirm(ctx context.Context, c *cli.Context, account *aws.AwsAccountResources, identity *aws.AccountIdentity) (*aws.AwsAccountResources, bool, error) {
+	if c.Bool("interactive") && len(account.Resources) > 0 {
+		selected, err := aws.PickResources(ctx, account)
+		if err != nil || selected == nil {
+			return nil, false, err
+		}
+		if len(selected.Resources) == 0 {
+			logging.Logger.Infoln("No resources were selected, so nothing will be nuked.")
+			return nil, false, nil
+		}
+
+		account = selected
+		nukableResources := aws.ExtractResourcesForPrinting(account)
+		logging.Logger.Infof("The following %d selected AWS resources will be nuked:", len(nukableResources))
+		for _, resource := range nukableResources {
+			logging.Logger.Infoln(resource)
+		}
+	}
+
+	proceed, err := confirmNuke(ctx, c.Bool("force"), identity)
+	return account, proceed, err
+}
+
+// nukeTarget is what a nuke runs against, which the user confirms by typing its ConfirmationValue
+type nukeTarget interface {
+	fmt.Stringer
+	ConfirmationValue() string
+	Matches(input string) bool
+}
+
+// describeTarget names the target in the confirmation messages
+func describeTarget(target nukeTarget) string {
+	if identity, ok := target.(*aws.AccountIdentity); ok {
+		return "account " + identity.String()
+	}
+	return target.String()
+}
+
+// confirmNuke asks the user to confirm nuking by typing the alias or ID of the account, or the number or IDs of the
+// accounts, or with --force, counts down for 10 seconds so the user can still abort
+func confirmNuke(ctx context.Context, force bool, target nukeTarget) (bool, error) {
+	if !force {
+		expected := fmt.Sprintf("'%s'", target.ConfirmationValue())
+		if _, ok := target.(accountsTarget); ok {
+			expected = fmt.Sprintf("the number of accounts ('%s') or the IDs of all of them", target.ConfirmationValue())
+		}
+		prompt := fmt.Sprintf("\nAre you sure you want to nuke all listed resources in %s? Enter %s to confirm (or exit with ^C): ", describeTarget(target), expected)
+		return confirmationPrompt(ctx, prompt, target, 2)
+	}
+
+	if withoutCountdown(ctx) {
+		return true, nil
+	}
+
+	logging.Logger.Infof("The --force flag is set, so waiting for 10 seconds before proceeding to nuke everything in %s. If you don't want to proceed, hit CTRL+C now!!", describeTarget(target))
+	for i := 10; i > 0; i-- {
+		fmt.Printf("%d...", i)
+		select {
+		case <-ctx.Done():
+			fmt.Println()
+			return false, errors.WithStackTrace(ctx.Err())
+		case <-time.After(1 * time.Second):
+		}
+	}
+
+	fmt.Println()
+	return true, nil
+}
+
+// accountRules returns the accounts that are allowed and denied by the --allow-account and --deny-account flags and
+// by the accounts rules of the config file
+func accountRules(c *cli.Context, configObj config.Config) ([]string, []string) {
+	allowed := append(c.StringSlice("allow-account"), configObj.Accounts.Allow...)
+	denied := append(c.StringSlice("deny-account"), configObj.Accounts.Deny...)
+	return allowed, denied
+}
+
+// checkAccount looks up the account the credentials belong to, and refuses root credentials and the accounts that
+// the --allow-account and --deny-account flags, or the accounts rules of the config file, don't allow
+func checkAccount(ctx context.Context, c *cli.Context, configObj config.Config) (*aws.AccountIdentity, error) {
+	identity, err := aws.GetAccountIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed, denied := accountRules(c, configObj)
+	if c.Bool("org") {
+		// With --org, the rules apply to the member accounts, not to the management account the run starts from
+		allowed, denied = nil, nil
+	}
+	if err := aws.CheckAccountAllowed(identity, allowed, denied); err != nil {
+		return nil, err
+	}
+
+	logging.Logger.Infof("Running against account %s", identity)
+	return identity, nil
+}
+
+// loadConfig reads the matching rules from the file given with --config, if any
+func loadConfig(c *cli.Context) (config.Config, error) {
+	configFilePath := c.String("config")
+	if configFilePath == "" {
+		return config.Config{}, nil
+	}
+
+	configObjPtr, err := config.GetConfig(configFilePath)
+	if err != nil {
+		return config.Config{}, fmt.Errorf("Error reading config - %s - %s", configFilePath, err)
+	}
+	return *configObjPtr, nil
+}
+
+// scanResources finds the resources selected by the command line flags, returning them together with the regions
+// that NukeAllResources should iterate over.
+func scanResources(ctx context.Context, c *cli.Context, configObj config.Config) (*aws.AwsAccountResources, []string, error) {
+	// Ensure that the resourceTypes and excludeResourceTypes arguments are valid, and then filter
+	// resourceTypes
+	selectedResourceTypes := c.StringSlice("resource-type")
+	if resourceTypesFilePath := c.String("resource-types-file"); resourceTypesFilePath != "" {
+		resourcesToNuke, err := aws.LoadNukePlan(resourceTypesFilePath)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, target := range resourcesToNuke.Targets {
+			selectedResourceTypes = append(selectedResourceTypes, target.String())
+		}
+	}
+
+	resourceTypes, err := aws.HandleResourceTypeSelections(ctx, selectedResourceTypes, c.StringSlice("exclude-resource-type"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Log which resource types will be nuked
+	logging.Logger.Info("The following resource types will be nuked:")
+	for _, resourceType := range resourceTypes {
+		logging.Logger.Infof("- %s", resourceType)
+	}
+
+	regions, err := aws.GetEnabledRegionsWithContext(ctx)
+	if err != nil {
+		return nil, nil, errors.WithStackTrace(err)
+	}
+
+	// global is a fake region, used to represent global resources
+	regions = append(regions, aws.GlobalRegion)
+
+	selectedRegions := c.StringSlice("region")
+	excludedRegions := c.StringSlice("exclude-region")
+
+	// targetRegions uses selectedRegions and excludedRegions to create a final
+	// target region slice.
+	targetRegions, err := aws.GetTargetRegions(regions, selectedRegions, excludedRegions)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to select regions: %s", err)
+	}
+
+	excludeAfter, err := parseDurationParam(c.String("older-than"))
+	if err != nil {
+		return nil, nil, errors.WithStackTrace(err)
+	}
+
+	logging.Logger.Infof("Retrieving active AWS resources in [%s]", strings.Join(targetRegions[:], ", "))
+	account, err := aws.GetAllResources(ctx, targetRegions, *excludeAfter, resourceTypes, configObj)
+	if err != nil {
+		return nil, nil, errors.WithStackTrace(err)
+	}
+
+	return account, regions, nil
+}
+
+func nukeAllResources(ctx context.Context, account *aws.AwsAccountResources, regions []string, opts aws.NukeOptions) error {
+	// Journal the planned deletions before submitting any of them, so that an interrupted run can be resumed
+	if err := opts.Run.Plan(account); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	err := aws.NukeAllResources(ctx, account, regions, opts)
+
+	logging.Logger.Infof("The journal of this run was written to %s", opts.Run.Path())
+	if pending := len(opts.Run.PendingEntries()); pending > 0 {
+		logging.Logger.Infof("%d deletion requests are still in progress. Check their outcome with: cloud-nuke status %s", pending, opts.Run.Path())
+	}
+	if remaining := len(opts.Run.RemainingEntries()); remaining > 0 {
+		logging.Logger.Infof("%d resources were not deleted. Retry them with: cloud-nuke aws --resume %s", remaining, opts.Run.Path())
+	}
+
+	return err
+}
+
+type promptResult struct {
+	input string
+	err   error
+}
+
+func confirmationPrompt(ctx context.Context, prompt string, target nukeTarget, maxPrompts int) (bool, error) {
+	color := color.New(color.FgHiRed, color.Bold)
+	color.Println("\nTHE NEXT STEPS ARE DESTRUCTIVE AND COMPLETELY IRREVERSIBLE, PROCEED WITH CAUTION!!!")
 
 	shellOptions := shell.ShellOptions{Logger: logging.Logger}
 
 	// retry prompt on invalid input so user can avoid rescanning all resources
 	prompts := 0
 	for prompts < maxPrompts {
-		input, err := shell.PromptUserForInput(prompt, &shellOptions)
-		if err != nil {
-			return false, errors.WithStackTrace(err)
+		// Read the input in the background, so that an interrupt aborts the prompt
+		results := make(chan promptResult, 1)
+		go func() {
+			input, err := shell.PromptUserForInput(prompt, &shellOptions)
+			results <- promptResult{input: input, err: err}
+		}()
+
+		var input string
+		select {
+		case <-ctx.Done():
+			fmt.Println()
+			return false, errors.WithStackTrace(ctx.Err())
+		case result := <-results:
+			if result.err != nil {
+				return false, errors.WithStackTrace(result.err)
+			}
+			input = result.input
 		}
 
-		if strings.ToLower(input) == "nuke" {
+		if target.Matches(input) {
 			return true, nil
 		}
 
@@ -232,9 +942,323 @@ func confirmationPrompt(prompt string, maxPrompts int) (bool, error) {
 	return false, nil
 }
 
+func awsStatus(c *cli.Context) error {
+	if err := setLogLevel(c); err != nil {
+		return err
+	}
+
+	runFilePath := c.Args().First()
+	if runFilePath == "" {
+		return InvalidFlagError{Name: "run file", Value: runFilePath}
+	}
+
+	maxWait, err := time.ParseDuration(c.String("max-wait"))
+	if err != nil {
+		return InvalidFlagError{Name: "max-wait", Value: c.String("max-wait")}
+	}
+
+	run, err := aws.LoadRun(runFilePath)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := interruptibleContext()
+	defer cancel()
+
+	configObj, err := loadConfig(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, err = configureClients(ctx, c, configObj)
+	if err != nil {
+		return err
+	}
+
+	// An interrupt only stops polling: the status gathered so far is still reported
+	refreshErr := aws.RefreshRunStatus(ctx, run, c.Bool("wait"), maxWait)
+	if refreshErr == nil && c.Bool("verify") {
+		// Resources that are still present are moved back to failed, and counted below
+		if err := aws.VerifyRun(ctx, run); err != nil {
+			if _, stillPresent := errors.Unwrap(err).(aws.ResourcesStillPresentError); !stillPresent {
+				refreshErr = err
+			}
+		}
+	}
+
+	aws.RenderRunStatus(run)
+
+	if refreshErr != nil {
+		return errors.WithStackTrace(refreshErr)
+	}
+
+	pending := len(run.PendingEntries())
+	if pending > 0 {
+		logging.Logger.Infof("%d deletion requests are still in progress. Run this command again later to check on them.", pending)
+	}
+
+	failed := len(run.FailedEntries())
+	if failed > 0 {
+		return aws.FailedDeletionRequestsError{Count: failed}
+	}
+
+	return nil
+}
+
+func awsPlan(c *cli.Context) error {
+	if err := setLogLevel(c); err != nil {
+		return err
+	}
+
+	outputPath := c.String("output")
+	if outputPath == "" {
+		return InvalidFlagError{Name: "output", Value: outputPath}
+	}
+
+	ctx, cancel := interruptibleContext()
+	defer cancel()
+
+	configObj, err := loadConfig(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, err = configureClients(ctx, c, configObj)
+	if err != nil {
+		return err
+	}
+
+	if err := aws.LoadPlugins(ctx, c.StringSlice("plugin-dir")); err != nil {
+		return err
+	}
+
+	if multiAccountFlag(c) != "" {
+		return awsPlanAccounts(ctx, c, configObj, outputPath)
+	}
+
+	account, _, err := scanResources(ctx, c, configObj)
+	if err != nil {
+		return err
+	}
+
+	plan, err := aws.CreateNukePlan(ctx, account)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if err := aws.WriteNukePlan(outputPath, plan); err != nil {
+		return err
+	}
+
+	logging.Logger.Infof("Wrote a plan of %d resources to %s. Nuke them with: cloud-nuke apply %s", len(plan.Resources), outputPath, outputPath)
+	return nil
+}
+
+// nukeOptions reads the options shared by the commands that nuke resources from their flags. The run is left unset.
+func nukeOptions(c *cli.Context) (aws.NukeOptions, error) {
+	nukeOpts := aws.NukeOptions{
+		Async:                    c.Bool("async"),
+		CancelPendingOnInterrupt: c.Bool("cancel-pending-on-interrupt"),
+		SkipPrepare:              c.Bool("skip-prepare"),
+		Verify:                   c.Bool("verify"),
+		BackupPath:               c.String("backup"),
+		SnapshotTypes:            c.StringSlice("snapshot-before-delete"),
+		SnapshotRetention:        time.Duration(c.Int("snapshot-retention-days")) * 24 * time.Hour,
+	}
+
+	for _, snapshotType := range nukeOpts.SnapshotTypes {
+		if snapshotType != "all" && !collections.ListContainsElement(aws.SnapshotResourceTypes(), snapshotType) {
+			return aws.NukeOptions{}, InvalidFlagError{Name: "snapshot-before-delete", Value: snapshotType}
+		}
+	}
+
+	if keyFile := c.String("backup-key-file"); keyFile != "" {
+		if nukeOpts.BackupPath == "" {
+			return aws.NukeOptions{}, InvalidFlagError{Name: "backup-key-file", Value: keyFile}
+		}
+		key, err := aws.LoadBackupKey(keyFile)
+		if err != nil {
+			return aws.NukeOptions{}, err
+		}
+		nukeOpts.BackupKey = key
+	}
+
+	return nukeOpts, nil
+}
+
+func awsApply(c *cli.Context) error {
+	if err := setLogLevel(c); err != nil {
+		return err
+	}
+
+	planPath := c.Args().First()
+	if planPath == "" {
+		return InvalidFlagError{Name: "plan file", Value: planPath}
+	}
+
+	maxAge, err := time.ParseDuration(c.String("max-age"))
+	if err != nil {
+		return InvalidFlagError{Name: "max-age", Value: c.String("max-age")}
+	}
+
+	if c.Bool("interactive") && c.Bool("force") {
+		return InvalidFlagError{Name: "interactive", Value: "true"}
+	}
+
+	nukeOpts, err := nukeOptions(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := interruptibleContext()
+	defer cancel()
+
+	configObj, err := loadConfig(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, err = configureClients(ctx, c, configObj)
+	if err != nil {
+		return err
+	}
+
+	// Plans can include plugin types, which must be registered to be deleted
+	if err := aws.LoadPlugins(ctx, c.StringSlice("plugin-dir")); err != nil {
+		return err
+	}
+
+	identity, err := checkAccount(ctx, c, configObj)
+	if err != nil {
+		return err
+	}
+
+	plan, err := aws.ReadNukePlan(planPath)
+	if err != nil {
+		return err
+	}
+
+	account, drift, err := aws.CheckNukePlan(ctx, plan, maxAge, time.Now())
+	if err != nil {
+		return err
+	}
+	if len(drift) > 0 {
+		logging.Logger.Warnf("%d resources changed since the plan was made", len(drift))
+		aws.RenderPlanDrift(drift)
+	}
+
+	if len(account.Resources) == 0 {
+		logging.Logger.Infoln("Nothing to nuke, you're all good!")
+		return nil
+	}
+
+	nukableResources := aws.ExtractResourcesForPrinting(account)
+	logging.Logger.Infof("The following %d AWS resources will be nuked:", len(nukableResources))
+	for _, resource := range nukableResources {
+		logging.Logger.Infoln(resource)
+	}
+
+	if c.Bool("dry-run") {
+		logging.Logger.Infoln("Not taking any action as dry-run set to true.")
+		return nil
+	}
+
+	account, proceed, err := pickAndConfirm(ctx, c, account, identity)
+	if err != nil || !proceed {
+		return err
+	}
+
+	nukeOpts.Run = aws.NewRun(c.String("run-file"))
+	return nukeAllResources(ctx, account, plan.Regions(), nukeOpts)
+}
+
+func awsDiff(c *cli.Context) error {
+	if c.NArg() != 2 {
+		return InvalidFlagError{Name: "inventory files", Value: strings.Join(c.Args(), " ")}
+	}
+
+	format := c.String("format")
+	if !collections.ListContainsElement(aws.DiffFormats, format) {
+		return InvalidFlagError{Name: "format", Value: format}
+	}
+
+	var key []byte
+	if keyFile := c.String("backup-key-file"); keyFile != "" {
+		var err error
+		key, err = aws.LoadBackupKey(keyFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	from, err := aws.ReadInventory(c.Args().Get(0), key)
+	if err != nil {
+		return err
+	}
+	to, err := aws.ReadInventory(c.Args().Get(1), key)
+	if err != nil {
+		return err
+	}
+
+	return aws.WriteNukePlanDiff(os.Stdout, aws.DiffNukePlans(from, to), format)
+}
+
+func awsRestore(c *cli.Context) error {
+	if err := setLogLevel(c); err != nil {
+		return err
+	}
+
+	archivePath := c.Args().First()
+	if archivePath == "" {
+		return InvalidFlagError{Name: "archive", Value: archivePath}
+	}
+
+	maxWait, err := time.ParseDuration(c.String("max-wait"))
+	if err != nil {
+		return InvalidFlagError{Name: "max-wait", Value: c.String("max-wait")}
+	}
+
+	var key []byte
+	if keyFile := c.String("backup-key-file"); keyFile != "" {
+		key, err = aws.LoadBackupKey(keyFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	backup, err := aws.ReadBackup(archivePath, key)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := interruptibleContext()
+	defer cancel()
+
+	configObj, err := loadConfig(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, err = configureClients(ctx, c, configObj)
+	if err != nil {
+		return err
+	}
+
+	_, err = aws.RestoreBackup(ctx, backup, aws.RestoreOptions{
+		Regions:              c.StringSlice("region"),
+		ResourceTypes:        c.StringSlice("resource-type"),
+		Identifiers:          c.StringSlice("identifier"),
+		DryRun:               c.Bool("dry-run"),
+		MaxWait:              maxWait,
+		AllowAccountMismatch: c.Bool("allow-account-mismatch"),
+	})
+	return err
+}
+
 func awsInspect(c *cli.Context) error {
+	ctx := context.Background()
+
 	logging.Logger.Infoln("Identifying enabled regions")
-	regions, err := aws.GetEnabledRegions()
+	regions, err := aws.GetEnabledRegionsWithContext(ctx)
 	if err != nil {
 		return errors.WithStackTrace(err)
 	}
@@ -243,7 +1267,7 @@ func awsInspect(c *cli.Context) error {
 	}
 
 	if c.Bool("list-resource-types") {
-		for _, resourceType := range aws.ListResourceTypes() {
+		for _, resourceType := range aws.ListResourceTypesWithContext(ctx) {
 			logging.Logger.Infoln(resourceType)
 		}
 		return nil
@@ -254,7 +1278,8 @@ func awsInspect(c *cli.Context) error {
 		return errors.WithStackTrace(err)
 	}
 
-	query, err := aws.NewQuery(
+	query, err := aws.NewQueryWithContext(
+		ctx,
 		c.StringSlice("region"),
 		c.StringSlice("exclude-region"),
 		c.StringSlice("resource-type"),
@@ -265,7 +1290,7 @@ func awsInspect(c *cli.Context) error {
 		return aws.QueryCreationError{Underlying: err}
 	}
 
-	accountResources, err := aws.InspectResources(query)
+	accountResources, err := aws.InspectResources(ctx, query)
 	if err != nil {
 		return errors.WithStackTrace(aws.ResourceInspectionError{Underlying: err})
 	}
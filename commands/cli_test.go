@@ -1,12 +1,21 @@
 package commands
 
 import (
+	"context"
+	"flag"
 	"testing"
 	"time"
 
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/golang/mock/gomock"
 	"github.com/gruntwork-io/cloud-nuke/aws"
+	mock_aws "github.com/gruntwork-io/cloud-nuke/aws/mocks/clients"
 	"github.com/gruntwork-io/go-commons/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
 )
 
 func TestParseDuration(t *testing.T) {
@@ -35,25 +44,84 @@ func TestParseDurationInvalidFormat(t *testing.T) {
 	assert.Error(t, err)
 }
 
+// listResourceTypes lists the resource types with a CloudFormation mock that returns the types of a page of ListTypes
+func listResourceTypes(t *testing.T) []string {
+	ctrl := gomock.NewController(t)
+	mockCloudFormation := mock_aws.NewMockCloudFormationAPI(ctrl)
+	mockCloudFormation.EXPECT().
+		ListTypes(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&cloudformation.ListTypesOutput{TypeSummaries: []types.TypeSummary{
+			{TypeName: awsgo.String("AWS::EC2::Instance")},
+			{TypeName: awsgo.String("AWS::EC2::LaunchTemplate")},
+		}}, nil)
+
+	ctx := aws.WithClientFactory(context.Background(), aws.StaticClientFactory{CloudFormationClient: mockCloudFormation})
+	return aws.ListResourceTypesWithContext(ctx)
+}
+
 func TestListResourceTypes(t *testing.T) {
-	allAWSResourceTypes := aws.ListResourceTypes()
+	allAWSResourceTypes := listResourceTypes(t)
 	assert.Greater(t, len(allAWSResourceTypes), 0)
-	assert.Contains(t, allAWSResourceTypes, aws.EC2Instances{}.ResourceName())
+	assert.Contains(t, allAWSResourceTypes, "AWS::EC2::Instance")
 }
 
 func TestIsValidResourceType(t *testing.T) {
-	allAWSResourceTypes := aws.ListResourceTypes()
-	ec2ResourceName := aws.EC2Instances{}.ResourceName()
+	allAWSResourceTypes := listResourceTypes(t)
+	ec2ResourceName := "AWS::EC2::Instance"
 	assert.Equal(t, aws.IsValidResourceType(ec2ResourceName, allAWSResourceTypes), true)
 	assert.Equal(t, aws.IsValidResourceType("xyz", allAWSResourceTypes), false)
 }
 
 func TestIsNukeable(t *testing.T) {
-	ec2ResourceName := aws.EC2Instances{}.ResourceName()
-	amiResourceName := aws.AMIs{}.ResourceName()
+	ec2ResourceName := "AWS::EC2::Instance"
+	launchTemplateResourceName := "AWS::EC2::LaunchTemplate"
 
 	assert.Equal(t, aws.IsNukeable(ec2ResourceName, []string{ec2ResourceName}), true)
 	assert.Equal(t, aws.IsNukeable(ec2ResourceName, []string{"all"}), true)
 	assert.Equal(t, aws.IsNukeable(ec2ResourceName, []string{}), true)
-	assert.Equal(t, aws.IsNukeable(ec2ResourceName, []string{amiResourceName}), false)
+	assert.Equal(t, aws.IsNukeable(ec2ResourceName, []string{launchTemplateResourceName}), false)
+}
+
+func TestApplyReadsTheNukeOptionsOfAws(t *testing.T) {
+	t.Parallel()
+
+	args := []string{
+		"--async",
+		"--cancel-pending-on-interrupt",
+		"--skip-prepare",
+		"--verify",
+		"--backup", "backup.json.gz",
+		"--snapshot-before-delete", "all",
+		"--snapshot-retention-days", "7",
+	}
+
+	options := map[string]aws.NukeOptions{}
+	for _, command := range CreateCli("test").Commands {
+		if command.Name != "aws" && command.Name != "apply" {
+			continue
+		}
+		set := flag.NewFlagSet(command.Name, flag.ContinueOnError)
+		for _, f := range command.Flags {
+			f.Apply(set)
+		}
+		require.NoError(t, set.Parse(args))
+		opts, err := nukeOptions(cli.NewContext(nil, set, nil))
+		require.NoError(t, err)
+		options[command.Name] = opts
+	}
+
+	require.Len(t, options, 2)
+	assert.Equal(t, options["aws"], options["apply"])
+	assert.Equal(t, 7*24*time.Hour, options["apply"].SnapshotRetention)
+}
+
+func TestRunAwsChecksTheFlagsBeforeCallingAws(t *testing.T) {
+	t.Parallel()
+
+	// The flags are checked before the account, so this fails without calling AWS
+	err := RunAws(WithoutCountdown(context.Background()), []string{"--force", "--interactive"})
+	assert.Equal(t, InvalidFlagError{Name: "interactive", Value: "true"}, errors.Unwrap(err))
+
+	err = RunAws(context.Background(), []string{"--no-such-flag"})
+	assert.Error(t, err)
 }
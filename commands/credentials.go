@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/externalcreds"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/urfave/cli"
+)
+
+// credentialsOptions reads the credentials flags. The role options are only valid with --role-arn, and --profile-glob,
+// which gets the credentials of each profile instead, is not valid with --profile, --role-arn or --org.
+func credentialsOptions(c *cli.Context) (externalcreds.Options, error) {
+	opts := externalcreds.Options{
+		Profile: c.String("profile"),
+		RoleArn: c.String("role-arn"),
+	}
+
+	// Roles are assumed with STS in the partition of the credentials: the one of --partition, or else the one of the
+	// first --region. Without either, it is the partition of the region of the profile or of the environment.
+	if id := c.String("partition"); id != "" {
+		partition, err := aws.PartitionByID(id)
+		if err != nil {
+			return externalcreds.Options{}, InvalidFlagError{Name: "partition", Value: id}
+		}
+		opts.Region = partition.DefaultRegion
+	} else if regions := c.StringSlice("region"); len(regions) > 0 {
+		opts.Region = regions[0]
+		if partition, err := aws.PartitionOfRegion(regions[0]); err == nil {
+			opts.Region = partition.DefaultRegion
+		}
+	}
+
+	if glob := c.String("profile-glob"); glob != "" && (opts.Profile != "" || opts.RoleArn != "" || c.Bool("org")) {
+		return externalcreds.Options{}, InvalidFlagError{Name: "profile-glob", Value: glob}
+	}
+
+	if opts.RoleArn == "" {
+		// --role-session-name has a default value, so it is checked for being set rather than for being empty
+		for _, name := range []string{"external-id", "role-session-name", "mfa-serial", "session-duration"} {
+			if value := c.String(name); value != "" && c.IsSet(name) {
+				return externalcreds.Options{}, InvalidFlagError{Name: name, Value: value}
+			}
+		}
+		return opts, nil
+	}
+
+	opts.ExternalID = c.String("external-id")
+	opts.RoleSessionName = c.String("role-session-name")
+	opts.MFASerial = c.String("mfa-serial")
+
+	if value := c.String("session-duration"); value != "" {
+		duration, err := time.ParseDuration(value)
+		if err != nil || duration <= 0 {
+			return externalcreds.Options{}, InvalidFlagError{Name: "session-duration", Value: value}
+		}
+		opts.SessionDuration = duration
+	}
+	return opts, nil
+}
+
+// configureCredentials resolves the credentials selected by the credentials flags, once, for every client the command
+// creates
+func configureCredentials(ctx context.Context, c *cli.Context) error {
+	opts, err := credentialsOptions(c)
+	if err != nil {
+		return err
+	}
+	return errors.WithStackTrace(externalcreds.Configure(ctx, opts))
+}
+
+// endpointOverrides merges the endpoints of the config file with those of the --endpoint flags, which take
+// precedence
+func endpointOverrides(c *cli.Context, configObj config.Config) (map[string]string, error) {
+	endpoints := map[string]string{}
+	for service, endpoint := range configObj.Endpoints {
+		endpoints[service] = endpoint
+	}
+	for _, value := range c.StringSlice("endpoint") {
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, InvalidFlagError{Name: "endpoint", Value: value}
+		}
+		endpoints[parts[0]] = parts[1]
+	}
+	return endpoints, nil
+}
+
+// configureClients sets the endpoint overrides, then resolves the credentials, for every client the command creates.
+// The returned context carries the partition of the credentials: the one of --partition, or else the one detected from
+// their caller identity. With --profile-glob, the credentials are those of each profile, so the partition is detected
+// by each call instead.
+func configureClients(ctx context.Context, c *cli.Context, configObj config.Config) (context.Context, error) {
+	endpoints, err := endpointOverrides(c, configObj)
+	if err != nil {
+		return ctx, err
+	}
+	if err := externalcreds.SetEndpoints(endpoints); err != nil {
+		return ctx, errors.WithStackTrace(err)
+	}
+	if err := configureCredentials(ctx, c); err != nil {
+		return ctx, err
+	}
+	if id := c.String("partition"); id != "" {
+		// credentialsOptions has already checked that the partition exists
+		partition, err := aws.PartitionByID(id)
+		return aws.WithPartition(ctx, partition), errors.WithStackTrace(err)
+	}
+	if c.String("profile-glob") != "" {
+		return ctx, nil
+	}
+
+	// The commands that need the caller identity fail on their own if it can't be read, so this isn't an error
+	partition, err := aws.DetectPartition(ctx)
+	if err != nil {
+		logging.Logger.Debugf("Could not detect the partition of the credentials: %s", err)
+		return ctx, nil
+	}
+	logging.Logger.Debugf("Running in partition %s", partition.ID)
+	return aws.WithPartition(ctx, partition), nil
+}
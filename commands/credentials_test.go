@@ -0,0 +1,152 @@
+package commands
+
+import (
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/externalcreds"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+func credentialsContext(t *testing.T, args ...string) *cli.Context {
+	set := flag.NewFlagSet("test", flag.ContinueOnError)
+	for _, f := range joinFlags(credentialsFlags, orgFlags, endpointFlags, []cli.Flag{cli.StringSliceFlag{Name: "region"}}) {
+		f.Apply(set)
+	}
+	require.NoError(t, set.Parse(args))
+	return cli.NewContext(nil, set, nil)
+}
+
+func TestCredentialsOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected externalcreds.Options
+		invalid  string
+	}{
+		{
+			name:     "default chain",
+			expected: externalcreds.Options{},
+		},
+		{
+			name:     "profile",
+			args:     []string{"--profile", "sandbox"},
+			expected: externalcreds.Options{Profile: "sandbox"},
+		},
+		{
+			name: "role",
+			args: []string{"--profile", "sandbox", "--role-arn", "arn:aws:iam::111111111111:role/nuke", "--external-id", "abc", "--mfa-serial", "arn:aws:iam::222222222222:mfa/me", "--session-duration", "15m"},
+			expected: externalcreds.Options{
+				Profile:         "sandbox",
+				RoleArn:         "arn:aws:iam::111111111111:role/nuke",
+				ExternalID:      "abc",
+				RoleSessionName: externalcreds.DefaultRoleSessionName,
+				MFASerial:       "arn:aws:iam::222222222222:mfa/me",
+				SessionDuration: 15 * time.Minute,
+			},
+		},
+		{
+			name:    "external ID without role",
+			args:    []string{"--external-id", "abc"},
+			invalid: "external-id",
+		},
+		{
+			name:    "session name without role",
+			args:    []string{"--role-session-name", "me"},
+			invalid: "role-session-name",
+		},
+		{
+			name:    "MFA without role",
+			args:    []string{"--mfa-serial", "arn:aws:iam::222222222222:mfa/me"},
+			invalid: "mfa-serial",
+		},
+		{
+			name:     "profile glob",
+			args:     []string{"--profile-glob", "sandbox-*"},
+			expected: externalcreds.Options{},
+		},
+		{
+			name:    "profile glob with profile",
+			args:    []string{"--profile-glob", "sandbox-*", "--profile", "sandbox"},
+			invalid: "profile-glob",
+		},
+		{
+			name:    "profile glob with role",
+			args:    []string{"--profile-glob", "sandbox-*", "--role-arn", "arn:aws:iam::111111111111:role/nuke"},
+			invalid: "profile-glob",
+		},
+		{
+			name:    "profile glob with org",
+			args:    []string{"--profile-glob", "sandbox-*", "--org"},
+			invalid: "profile-glob",
+		},
+		{
+			name:     "partition",
+			args:     []string{"--partition", "aws-cn", "--role-arn", "arn:aws-cn:iam::111111111111:role/nuke"},
+			expected: externalcreds.Options{RoleArn: "arn:aws-cn:iam::111111111111:role/nuke", RoleSessionName: externalcreds.DefaultRoleSessionName, Region: "cn-north-1"},
+		},
+		{
+			name:     "partition of region",
+			args:     []string{"--region", "cn-northwest-1", "--region", "eu-west-1", "--profile", "china"},
+			expected: externalcreds.Options{Profile: "china", Region: "cn-north-1"},
+		},
+		{
+			name:     "region not in the catalog",
+			args:     []string{"--region", "xx-new-1", "--profile", "sandbox"},
+			expected: externalcreds.Options{Profile: "sandbox", Region: "xx-new-1"},
+		},
+		{
+			name:     "partition before region",
+			args:     []string{"--partition", "aws-us-gov", "--region", "eu-west-1", "--profile", "gov"},
+			expected: externalcreds.Options{Profile: "gov", Region: "us-gov-west-1"},
+		},
+		{
+			name:    "unknown partition",
+			args:    []string{"--partition", "aws-mars"},
+			invalid: "partition",
+		},
+		{
+			name:    "invalid session duration",
+			args:    []string{"--role-arn", "arn:aws:iam::111111111111:role/nuke", "--session-duration", "forever"},
+			invalid: "session-duration",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, err := credentialsOptions(credentialsContext(t, tt.args...))
+			if tt.invalid != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.invalid, err.(InvalidFlagError).Name)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, opts)
+		})
+	}
+}
+
+func TestEndpointOverrides(t *testing.T) {
+	t.Parallel()
+
+	configObj := config.Config{Endpoints: config.Endpoints{"ec2": "http://config:4566", "sts": "http://config:4566"}}
+	endpoints, err := endpointOverrides(credentialsContext(t, "--endpoint", "ec2=http://flag:4566", "--endpoint", "cloudcontrol=http://flag:4566"), configObj)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"cloudcontrol": "http://flag:4566",
+		"ec2":          "http://flag:4566",
+		"sts":          "http://config:4566",
+	}, endpoints)
+
+	_, err = endpointOverrides(credentialsContext(t, "--endpoint", "http://localhost:4566"), config.Config{})
+	assert.Equal(t, InvalidFlagError{Name: "endpoint", Value: "http://localhost:4566"}, err)
+}
@@ -0,0 +1,292 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/urfave/cli"
+)
+
+// orgOptions reads the --org-* flags
+func orgOptions(c *cli.Context) (aws.OrgOptions, error) {
+	opts := aws.OrgOptions{
+		OrganizationalUnits: c.StringSlice("org-unit"),
+		AccountIDs:          c.StringSlice("org-account"),
+		RoleName:            c.String("org-role-name"),
+		Parallelism:         c.Int("org-parallelism"),
+		Tags:                map[string]string{},
+	}
+
+	for _, tag := range c.StringSlice("org-tag") {
+		parts := strings.SplitN(tag, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return aws.OrgOptions{}, InvalidFlagError{Name: "org-tag", Value: tag}
+		}
+		opts.Tags[parts[0]] = parts[1]
+	}
+
+	if opts.RoleName == "" {
+		return aws.OrgOptions{}, InvalidFlagError{Name: "org-role-name", Value: opts.RoleName}
+	}
+	if opts.Parallelism < 1 {
+		return aws.OrgOptions{}, InvalidFlagError{Name: "org-parallelism", Value: c.String("org-parallelism")}
+	}
+	return opts, nil
+}
+
+// selectOrgAccounts lists the member accounts selected by the --org-* flags. Accounts that the accounts rules don't
+// allow are left out, and recorded as skipped in the report.
+func selectOrgAccounts(ctx context.Context, c *cli.Context, configObj config.Config) ([]aws.OrgAccount, *aws.OrgReport, aws.OrgOptions, error) {
+	opts, err := orgOptions(c)
+	if err != nil {
+		return nil, nil, opts, err
+	}
+
+	members, err := aws.ListOrgAccounts(ctx, opts)
+	if err != nil {
+		return nil, nil, opts, err
+	}
+
+	report := aws.NewOrgReport()
+	allowed, denied := accountRules(c, configObj)
+	accounts := []aws.OrgAccount{}
+	for _, account := range members {
+		if err := aws.CheckAccountAllowed(&aws.AccountIdentity{AccountID: account.ID}, allowed, denied); err != nil {
+			logging.Logger.Warnf("Skipping account %s: %s", account.ID, errors.Unwrap(err))
+			report.Result(account).Skipped = errors.Unwrap(err).Error()
+			continue
+		}
+		accounts = append(accounts, account)
+	}
+
+	logging.Logger.Infof("Running against %d member accounts of the organization", len(accounts))
+	return accounts, report, opts, nil
+}
+
+// selectProfileAccounts lists the accounts of the profiles that match --profile-glob, once per account. Accounts that
+// the accounts rules don't allow, and profiles with root credentials, are left out, and recorded as skipped in the
+// report.
+func selectProfileAccounts(ctx context.Context, c *cli.Context, configObj config.Config) ([]aws.OrgAccount, *aws.OrgReport, aws.OrgOptions, error) {
+	opts, err := orgOptions(c)
+	if err != nil {
+		return nil, nil, opts, err
+	}
+
+	profileAccounts, err := aws.ListProfileAccounts(ctx, c.String("profile-glob"))
+	if err != nil {
+		return nil, nil, opts, err
+	}
+
+	report := aws.NewOrgReport()
+	allowed, denied := accountRules(c, configObj)
+	accounts := []aws.OrgAccount{}
+	for _, profileAccount := range profileAccounts {
+		account := profileAccount.OrgAccount()
+		if err := aws.CheckAccountAllowed(profileAccount.Identity, allowed, denied); err != nil {
+			logging.Logger.Warnf("Skipping account %s of profile %s: %s", account.ID, account.Name, errors.Unwrap(err))
+			report.Result(account).Skipped = errors.Unwrap(err).Error()
+			continue
+		}
+		accounts = append(accounts, account)
+	}
+
+	logging.Logger.Infof("Running against the %d accounts of the profiles matching %q", len(accounts), c.String("profile-glob"))
+	return accounts, report, opts, nil
+}
+
+// selectAccounts lists the accounts to run against, with --org or with --profile-glob
+func selectAccounts(ctx context.Context, c *cli.Context, configObj config.Config) ([]aws.OrgAccount, *aws.OrgReport, aws.OrgOptions, error) {
+	if c.String("profile-glob") != "" {
+		return selectProfileAccounts(ctx, c, configObj)
+	}
+	return selectOrgAccounts(ctx, c, configObj)
+}
+
+// multiAccountFlag returns the flag that makes the command run against several accounts, or an empty string if it
+// runs against the current account only
+func multiAccountFlag(c *cli.Context) string {
+	if c.String("profile-glob") != "" {
+		return "profile-glob"
+	}
+	if c.Bool("org") {
+		return "org"
+	}
+	return ""
+}
+
+// accountsTarget is what the user confirms when nuking several accounts with --org or --profile-glob: they type how
+// many accounts there are, or the IDs of all of them, in any order
+type accountsTarget struct {
+	// scope describes where the accounts were selected from
+	scope    string
+	accounts []aws.OrgAccount
+}
+
+func (target accountsTarget) String() string {
+	accounts := []string{}
+	for _, account := range target.accounts {
+		if account.Name != "" {
+			accounts = append(accounts, fmt.Sprintf("%s (%s)", account.ID, account.Name))
+		} else {
+			accounts = append(accounts, account.ID)
+		}
+	}
+	return fmt.Sprintf("%d accounts of %s: %s", len(target.accounts), target.scope, strings.Join(accounts, ", "))
+}
+
+func (target accountsTarget) ConfirmationValue() string {
+	return strconv.Itoa(len(target.accounts))
+}
+
+func (target accountsTarget) Matches(input string) bool {
+	input = strings.TrimSpace(input)
+	if input == target.ConfirmationValue() {
+		return true
+	}
+
+	entered := map[string]bool{}
+	for _, accountID := range strings.FieldsFunc(input, func(r rune) bool { return r == ',' || unicode.IsSpace(r) }) {
+		entered[accountID] = true
+	}
+	if len(entered) != len(target.accounts) {
+		return false
+	}
+	for _, account := range target.accounts {
+		if !entered[account.ID] {
+			return false
+		}
+	}
+	return true
+}
+
+// orgFilePath inserts the account ID in a file path given on the command line, so that every account of an --org or
+// --profile-glob run writes its own file
+func orgFilePath(path, accountID string) string {
+	if path == "" {
+		return ""
+	}
+	extension := filepath.Ext(path)
+	return strings.TrimSuffix(path, extension) + "-" + accountID + extension
+}
+
+// finishOrgRun prints the report, writes it to the --org-report file, and fails if any account failed
+func finishOrgRun(c *cli.Context, report *aws.OrgReport) error {
+	aws.RenderOrgReport(report)
+
+	if reportPath := c.String("org-report"); reportPath != "" {
+		if err := aws.WriteOrgReport(reportPath, report); err != nil {
+			return err
+		}
+		logging.Logger.Infof("The report of this run was written to %s", reportPath)
+	}
+
+	if failed := report.FailedCount(); failed > 0 {
+		return aws.OrgAccountsFailedError{Count: failed}
+	}
+	return nil
+}
+
+// awsNukeAccounts scans the accounts selected with --org or --profile-glob, described by scope, asks for a single
+// confirmation that names the accounts with resources to nuke, then nukes each account with its own run file
+func awsNukeAccounts(ctx context.Context, c *cli.Context, configObj config.Config, nukeOpts aws.NukeOptions, scope string) error {
+	accounts, report, orgOpts, err := selectAccounts(ctx, c, configObj)
+	if err != nil {
+		return err
+	}
+
+	var mutex sync.Mutex
+	scans := map[string]*aws.AwsAccountResources{}
+	scannedRegions := map[string][]string{}
+	aws.RunInOrgAccounts(ctx, report, accounts, orgOpts, func(ctx context.Context, result *aws.OrgAccountResult) error {
+		account, regions, err := scanResources(ctx, c, configObj)
+		if err != nil {
+			return err
+		}
+		result.Resources = len(aws.ExtractResourcesForPrinting(account))
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		scans[result.AccountID] = account
+		scannedRegions[result.AccountID] = regions
+		return nil
+	})
+
+	toNuke := []aws.OrgAccount{}
+	for _, account := range accounts {
+		scan, ok := scans[account.ID]
+		if !ok || len(scan.Resources) == 0 {
+			continue
+		}
+		toNuke = append(toNuke, account)
+
+		nukableResources := aws.ExtractResourcesForPrinting(scan)
+		logging.Logger.Infof("The following %d AWS resources will be nuked in account %s (%s):", len(nukableResources), account.ID, account.Name)
+		for _, resource := range nukableResources {
+			logging.Logger.Infoln(resource)
+		}
+	}
+
+	if len(toNuke) == 0 {
+		logging.Logger.Infoln("Nothing to nuke, you're all good!")
+		return finishOrgRun(c, report)
+	}
+
+	if c.Bool("dry-run") {
+		logging.Logger.Infoln("Not taking any action as dry-run set to true.")
+		return finishOrgRun(c, report)
+	}
+
+	logging.Logger.Infof("Resources will be nuked in %d accounts", len(toNuke))
+	proceed, err := confirmNuke(ctx, c.Bool("force"), accountsTarget{scope: scope, accounts: toNuke})
+	if err != nil || !proceed {
+		return err
+	}
+
+	aws.RunInOrgAccounts(ctx, report, toNuke, orgOpts, func(ctx context.Context, result *aws.OrgAccountResult) error {
+		opts := nukeOpts
+		opts.Run = aws.NewRun(orgFilePath(c.String("run-file"), result.AccountID))
+		opts.BackupPath = orgFilePath(nukeOpts.BackupPath, result.AccountID)
+		result.RunFile = opts.Run.Path()
+
+		err := nukeAllResources(ctx, scans[result.AccountID], scannedRegions[result.AccountID], opts)
+		result.Remaining = len(opts.Run.RemainingEntries())
+		return err
+	})
+
+	return finishOrgRun(c, report)
+}
+
+// awsPlanAccounts writes a plan file for each account selected with --org or --profile-glob
+func awsPlanAccounts(ctx context.Context, c *cli.Context, configObj config.Config, outputPath string) error {
+	accounts, report, orgOpts, err := selectAccounts(ctx, c, configObj)
+	if err != nil {
+		return err
+	}
+
+	aws.RunInOrgAccounts(ctx, report, accounts, orgOpts, func(ctx context.Context, result *aws.OrgAccountResult) error {
+		account, _, err := scanResources(ctx, c, configObj)
+		if err != nil {
+			return err
+		}
+
+		plan, err := aws.CreateNukePlan(ctx, account)
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		result.PlanFile = orgFilePath(outputPath, result.AccountID)
+		result.Resources = len(plan.Resources)
+		return aws.WriteNukePlan(result.PlanFile, plan)
+	})
+
+	return finishOrgRun(c, report)
+}
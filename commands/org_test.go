@@ -0,0 +1,37 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrgFilePath(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "plan-111111111111.json", orgFilePath("plan.json", "111111111111"))
+	assert.Equal(t, "out/run-111111111111", orgFilePath("out/run", "111111111111"))
+	assert.Equal(t, "", orgFilePath("", "111111111111"))
+}
+
+func TestAccountsTarget(t *testing.T) {
+	t.Parallel()
+
+	target := accountsTarget{
+		scope:    `the profiles matching "sandbox-*"`,
+		accounts: []aws.OrgAccount{{ID: "111111111111", Name: "sandbox-a"}, {ID: "222222222222"}},
+	}
+	assert.Equal(t, "2", target.ConfirmationValue())
+	assert.Equal(t, `2 accounts of the profiles matching "sandbox-*": 111111111111 (sandbox-a), 222222222222`, describeTarget(target))
+
+	assert.True(t, target.Matches(" 2 "))
+	assert.True(t, target.Matches("222222222222, 111111111111"))
+	assert.True(t, target.Matches("111111111111 222222222222"))
+	assert.False(t, target.Matches("3"))
+	assert.False(t, target.Matches("111111111111"))
+	assert.False(t, target.Matches("111111111111 222222222222 333333333333"))
+	assert.False(t, target.Matches("sandbox-*"))
+
+	assert.Equal(t, "account 111111111111 as arn:aws:iam::111111111111:user/me", describeTarget(&aws.AccountIdentity{AccountID: "111111111111", CallerArn: "arn:aws:iam::111111111111:user/me"}))
+}
@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/gruntwork-io/cloud-nuke/logging"
+)
+
+// interruptibleContext returns the root context of a command. It is cancelled on the first SIGINT or SIGTERM, so
+// cloud-nuke can stop scheduling new work and still report on what already happened. Signal handling is reset at
+// that point, so a second signal terminates the process immediately.
+func interruptibleContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		select {
+		case sig := <-signals:
+			logging.Logger.Warnf("Received %s: finishing in-flight work and reporting results. Send it again to exit immediately.", sig)
+			signal.Stop(signals)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, func() {
+		signal.Stop(signals)
+		cancel()
+	}
+}
+
+// withoutCountdownKey is the context key set by WithoutCountdown
+type withoutCountdownKey struct{}
+
+// WithoutCountdown returns a copy of ctx in which --force nukes right away, instead of counting down to give the
+// user a chance to hit CTRL+C. Scenario tests use it, as nobody is there to interrupt them.
+func WithoutCountdown(ctx context.Context) context.Context {
+	return context.WithValue(ctx, withoutCountdownKey{}, true)
+}
+
+// withoutCountdown returns true if ctx was returned by WithoutCountdown
+func withoutCountdown(ctx context.Context) bool {
+	skip, _ := ctx.Value(withoutCountdownKey{}).(bool)
+	return skip
+}
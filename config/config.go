@@ -36,8 +36,21 @@ type Config struct {
 	EKSCluster            ResourceType `yaml:"EKSCluster"`
 	SageMakerNotebook     ResourceType `yaml:"SageMakerNotebook"`
 	KinesisStream         ResourceType `yaml:"KinesisStream"`
+	Accounts              AccountRules `yaml:"accounts"`
+	Endpoints             Endpoints    `yaml:"endpoints"`
 }
 
+// AccountRules - the accounts cloud-nuke may run against. Accounts in Deny, such as production accounts, are always
+// refused; when Allow is not empty, only the accounts in it are accepted.
+type AccountRules struct {
+	Allow []string `yaml:"allow"`
+	Deny  []string `yaml:"deny"`
+}
+
+// Endpoints - the endpoint URLs that override those of services, keyed by service, such as to point cloud-nuke at
+// LocalStack
+type Endpoints map[string]string
+
 type ResourceType struct {
 	IncludeRule FilterRule `yaml:"include"`
 	ExcludeRule FilterRule `yaml:"exclude"`
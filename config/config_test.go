@@ -37,6 +37,8 @@ func emptyConfig() *Config {
 		ResourceType{FilterRule{}, FilterRule{}},
 		ResourceType{FilterRule{}, FilterRule{}},
 		ResourceType{FilterRule{}, FilterRule{}},
+		AccountRules{},
+		nil,
 	}
 }
 
@@ -683,3 +685,27 @@ func TestShouldInclude_WhenMatchesIncludeAndExclude(t *testing.T) {
 	assert.False(t, ShouldInclude("terraform-tf-state", includeREs, excludeREs),
 		"Should not include when doesn't matches 'include' list")
 }
+
+// Accounts Tests
+
+func TestConfigAccounts(t *testing.T) {
+	configFilePath := "./mocks/accounts.yaml"
+	configObj, err := GetConfig(configFilePath)
+
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"111111111111", "222222222222"}, configObj.Accounts.Allow)
+	assert.Equal(t, []string{"999999999999"}, configObj.Accounts.Deny)
+}
+
+func TestConfigEndpoints(t *testing.T) {
+	configFilePath := "./mocks/endpoints.yaml"
+	configObj, err := GetConfig(configFilePath)
+
+	require.NoError(t, err)
+
+	assert.Equal(t, Endpoints{
+		"cloudcontrol": "http://localhost:4566",
+		"sts":          "http://localhost:4566",
+	}, configObj.Endpoints)
+}
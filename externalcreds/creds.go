@@ -2,31 +2,158 @@ package externalcreds
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 )
 
+// DefaultRoleSessionName is the session name of the roles cloud-nuke assumes, unless another one is configured
+const DefaultRoleSessionName = "cloud-nuke"
+
 var externalConfig *aws.Config
 
+// credentialsKey is the context key of the credentials provider set by WithCredentials
+type credentialsKey struct{}
+
+// Options are where cloud-nuke gets its credentials from, instead of the default credential chain
+type Options struct {
+	// Profile is the shared config profile to get credentials from
+	Profile string
+	// RoleArn is a role to assume, with the credentials of Profile or of the default credential chain
+	RoleArn string
+	// ExternalID is the external ID the trust policy of RoleArn requires, if any
+	ExternalID string
+	// RoleSessionName is the session name of RoleArn. Defaults to DefaultRoleSessionName.
+	RoleSessionName string
+	// MFASerial is the serial number or ARN of the MFA device that assuming RoleArn requires, if any. The token code
+	// is read from stdin.
+	MFASerial string
+	// SessionDuration is how long the credentials of RoleArn last. Defaults to the STS default of one hour.
+	SessionDuration time.Duration
+	// Region is the region of the STS client that resolves the credentials, which must be in their partition, such as
+	// cn-north-1 for the credentials of a China account. Defaults to the region of Profile or of the environment.
+	// Resolve fails with an UnknownResolveRegionError if none of them sets one.
+	Region string
+}
+
+// Set makes every client use the credentials of opts, instead of the default credential chain. Programs that embed
+// cloud-nuke can use it to inject their own credentials provider.
 func Set(opts *aws.Config) {
 	externalConfig = opts
 }
 
-func Get(region string) (aws.Config, error) {
-	// optsFuncs := []*config.LoadOptionsFunc{}
+// Configure resolves the credentials of opts once, and Sets them for every client. MFA token codes are read here, so
+// that the user is only asked once. Options that only set the Region keep the default credential chain.
+func Configure(ctx context.Context, opts Options) error {
+	if (opts == Options{Region: opts.Region}) {
+		return nil
+	}
+
+	credentials, err := Resolve(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	Set(&aws.Config{Credentials: credentials})
+	return nil
+}
+
+// Resolve returns the credentials of opts, after retrieving them once, so that a missing profile, a denied role or
+// a wrong MFA token code fails here rather than in the first client
+func Resolve(ctx context.Context, opts Options) (aws.CredentialsProvider, error) {
+	optsFuncs := []func(*config.LoadOptions) error{
+		// Profiles that assume a role with MFA ask for the token code
+		config.WithAssumeRoleCredentialOptions(func(options *stscreds.AssumeRoleOptions) {
+			options.TokenProvider = stscreds.StdinTokenProvider
+		}),
+	}
+	if opts.Region != "" {
+		optsFuncs = append(optsFuncs, config.WithRegion(opts.Region))
+	}
+	if opts.Profile != "" {
+		optsFuncs = append(optsFuncs, config.WithSharedConfigProfile(opts.Profile))
+	}
+	optsFuncs = append(optsFuncs, endpointOptions()...)
 
-	/* TODO
-	if externalConfig != nil {
-		loadOptions.Credentials = externalConfig.Credentials
-	}*/
+	baseConfig, err := config.LoadDefaultConfig(ctx, optsFuncs...)
+	if err != nil {
+		return nil, err
+	}
+	// Guessing a region could call STS outside the partition of the credentials, which rejects them
+	if baseConfig.Region == "" {
+		return nil, UnknownResolveRegionError{Profile: opts.Profile}
+	}
 
-	awsConfig, loadConfigErr := config.LoadDefaultConfig(
-		context.TODO(),
+	provider := baseConfig.Credentials
+	if opts.RoleArn != "" {
+		provider = stscreds.NewAssumeRoleProvider(sts.NewFromConfig(baseConfig), opts.RoleArn, func(options *stscreds.AssumeRoleOptions) {
+			options.RoleSessionName = opts.RoleSessionName
+			if options.RoleSessionName == "" {
+				options.RoleSessionName = DefaultRoleSessionName
+			}
+			if opts.ExternalID != "" {
+				options.ExternalID = aws.String(opts.ExternalID)
+			}
+			if opts.MFASerial != "" {
+				options.SerialNumber = aws.String(opts.MFASerial)
+				options.TokenProvider = stscreds.StdinTokenProvider
+			}
+			if opts.SessionDuration > 0 {
+				options.Duration = opts.SessionDuration
+			}
+		})
+	}
+
+	// Every client shares the cache, so credentials are only resolved again when they expire
+	credentials := aws.NewCredentialsCache(provider)
+	if _, err := credentials.Retrieve(ctx); err != nil {
+		return nil, err
+	}
+	return credentials, nil
+}
+
+// WithCredentials returns a copy of ctx whose clients authenticate with provider instead of the default credential
+// chain, such as the credentials of a role assumed in another account
+func WithCredentials(ctx context.Context, provider aws.CredentialsProvider) context.Context {
+	return context.WithValue(ctx, credentialsKey{}, provider)
+}
+
+// Get loads the config of the clients for region, with the endpoint overrides of SetEndpoints. Their credentials are,
+// in order of precedence, those set on ctx with WithCredentials, those Set by Configure or an embedding program, or
+// the default credential chain.
+func Get(ctx context.Context, region string) (aws.Config, error) {
+	optsFuncs := []func(*config.LoadOptions) error{
 		config.WithRegion(region),
-	)
+	}
+	optsFuncs = append(optsFuncs, endpointOptions()...)
+
+	if provider, ok := ctx.Value(credentialsKey{}).(aws.CredentialsProvider); ok {
+		optsFuncs = append(optsFuncs, config.WithCredentialsProvider(provider))
+	} else if externalConfig != nil && externalConfig.Credentials != nil {
+		optsFuncs = append(optsFuncs, config.WithCredentialsProvider(externalConfig.Credentials))
+	}
+
+	awsConfig, loadConfigErr := config.LoadDefaultConfig(ctx, optsFuncs...)
 	if loadConfigErr != nil {
 		return aws.Config{}, loadConfigErr
 	}
 	return awsConfig, nil
 }
+
+// UnknownResolveRegionError is returned by Resolve when neither the options, the profile nor the environment set the
+// region of the STS client that resolves the credentials
+type UnknownResolveRegionError struct {
+	Profile string
+}
+
+func (err UnknownResolveRegionError) Error() string {
+	source := "the environment"
+	if err.Profile != "" {
+		source = fmt.Sprintf("profile %q", err.Profile)
+	}
+	return fmt.Sprintf("could not determine the partition of the credentials, as %s sets no region: pass --partition or --region, or set AWS_REGION", source)
+}
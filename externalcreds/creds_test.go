@@ -0,0 +1,77 @@
+package externalcreds
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCredentialsPrecedence(t *testing.T) {
+	defer Set(nil)
+
+	Set(&aws.Config{Credentials: credentials.NewStaticCredentialsProvider("SET", "secret", "")})
+
+	config, err := Get(context.Background(), "eu-west-1")
+	require.NoError(t, err)
+	assert.Equal(t, "eu-west-1", config.Region)
+	creds, err := config.Credentials.Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "SET", creds.AccessKeyID)
+
+	ctx := WithCredentials(context.Background(), credentials.NewStaticCredentialsProvider("CONTEXT", "secret", ""))
+	config, err = Get(ctx, "eu-west-1")
+	require.NoError(t, err)
+	creds, err = config.Credentials.Retrieve(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "CONTEXT", creds.AccessKeyID)
+}
+
+func TestConfigureWithoutOptionsKeepsDefaultChain(t *testing.T) {
+	defer Set(nil)
+
+	require.NoError(t, Configure(context.Background(), Options{}))
+	assert.Nil(t, externalConfig)
+
+	require.NoError(t, Configure(context.Background(), Options{Region: "cn-north-1"}))
+	assert.Nil(t, externalConfig)
+}
+
+func TestResolveWithoutRegionFails(t *testing.T) {
+	dir := t.TempDir()
+	setEnv(t, map[string]string{
+		"AWS_REGION":                  "",
+		"AWS_DEFAULT_REGION":          "",
+		"AWS_PROFILE":                 "",
+		"AWS_CONFIG_FILE":             filepath.Join(dir, "config"),
+		"AWS_SHARED_CREDENTIALS_FILE": filepath.Join(dir, "credentials"),
+		"AWS_ACCESS_KEY_ID":           "AKID",
+		"AWS_SECRET_ACCESS_KEY":       "secret",
+	})
+
+	_, err := Resolve(context.Background(), Options{RoleArn: "arn:aws-cn:iam::111111111111:role/nuke"})
+	require.Error(t, err)
+	assert.Equal(t, UnknownResolveRegionError{}, err)
+	assert.Contains(t, err.Error(), "--partition or --region")
+}
+
+// setEnv sets the environment variables for the duration of the test
+func setEnv(t *testing.T, env map[string]string) {
+	for name, value := range env {
+		previous, ok := os.LookupEnv(name)
+		require.NoError(t, os.Setenv(name, value))
+		name := name
+		t.Cleanup(func() {
+			if ok {
+				os.Setenv(name, previous)
+			} else {
+				os.Unsetenv(name)
+			}
+		})
+	}
+}
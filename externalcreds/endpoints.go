@@ -0,0 +1,121 @@
+package externalcreds
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/gruntwork-io/go-commons/collections"
+)
+
+// EndpointServices are the services whose endpoint can be overridden, such as to point cloud-nuke at LocalStack. They
+// are every service that cloud-nuke calls.
+var EndpointServices = []string{
+	"cloudcontrol",
+	"cloudformation",
+	"ec2",
+	"ecr",
+	"elasticache",
+	"iam",
+	"organizations",
+	"rds",
+	"redshift",
+	"route53",
+	"s3",
+	"sts",
+}
+
+// endpointServiceAliases map the aws-sdk-go endpoints IDs that differ from the names in EndpointServices
+var endpointServiceAliases = map[string]string{
+	"cloudcontrolapi": "cloudcontrol",
+	"api.ecr":         "ecr",
+}
+
+// endpointOverrides are the endpoint URLs of the services whose endpoint is overridden, keyed by service
+var endpointOverrides = map[string]string{}
+
+// SetEndpoints makes every client of the services in endpoints call the given URL instead of the AWS endpoint. It
+// returns an InvalidEndpointError, and changes nothing, if a service is not in EndpointServices or a URL is invalid.
+func SetEndpoints(endpoints map[string]string) error {
+	overrides := map[string]string{}
+	for service, endpoint := range endpoints {
+		service = strings.ToLower(service)
+		if !collections.ListContainsElement(EndpointServices, service) {
+			return InvalidEndpointError{Service: service, URL: endpoint}
+		}
+		parsed, err := url.Parse(endpoint)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			return InvalidEndpointError{Service: service, URL: endpoint}
+		}
+		overrides[service] = endpoint
+	}
+	endpointOverrides = overrides
+	return nil
+}
+
+// Endpoint returns the URL that overrides the endpoint of service, or an empty string if it isn't overridden.
+// Services can be given by their aws-sdk-go-v2 service ID, such as "CloudFormation", or by their aws-sdk-go endpoints
+// ID, such as "cloudcontrolapi".
+func Endpoint(service string) string {
+	return endpointOverrides[endpointService(service)]
+}
+
+// endpointService returns the name in EndpointServices of a service given by its aws-sdk-go-v2 service ID or its
+// aws-sdk-go endpoints ID
+func endpointService(service string) string {
+	service = strings.ToLower(strings.ReplaceAll(service, " ", ""))
+	if alias, ok := endpointServiceAliases[service]; ok {
+		return alias
+	}
+	return service
+}
+
+// ResolveEndpoint resolves the endpoints of aws-sdk-go (v1) clients, such as the S3 and RDS ones of newSession, with
+// the overrides. Without overrides, it falls back to the default endpoints. Once any endpoint is overridden, it
+// returns an EndpointNotOverriddenError for the services that aren't, so that a run against a stand-in for AWS never
+// prepares or snapshots the resources it found there in the real account.
+func ResolveEndpoint(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+	if endpoint := Endpoint(service); endpoint != "" {
+		return endpoints.ResolvedEndpoint{URL: endpoint, SigningRegion: region}, nil
+	}
+	if len(endpointOverrides) > 0 {
+		return endpoints.ResolvedEndpoint{}, EndpointNotOverriddenError{Service: endpointService(service)}
+	}
+	return endpoints.DefaultResolver().EndpointFor(service, region, opts...)
+}
+
+// endpointOptions resolves the endpoints of aws-sdk-go-v2 clients with the overrides. Services that aren't
+// overridden fall back to the default endpoints.
+func endpointOptions() []func(*config.LoadOptions) error {
+	if len(endpointOverrides) == 0 {
+		return nil
+	}
+
+	resolver := aws.EndpointResolverWithOptionsFunc(func(service, region string, options ...interface{}) (aws.Endpoint, error) {
+		if endpoint := Endpoint(service); endpoint != "" {
+			return aws.Endpoint{URL: endpoint, SigningRegion: region, HostnameImmutable: true}, nil
+		}
+		return aws.Endpoint{}, &aws.EndpointNotFoundError{}
+	})
+	return []func(*config.LoadOptions) error{config.WithEndpointResolverWithOptions(resolver)}
+}
+
+type InvalidEndpointError struct {
+	Service string
+	URL     string
+}
+
+func (err InvalidEndpointError) Error() string {
+	return fmt.Sprintf("invalid endpoint %q for service %q: the service must be one of %s, and the endpoint a URL such as http://localhost:4566", err.URL, err.Service, strings.Join(EndpointServices, ", "))
+}
+
+type EndpointNotOverriddenError struct {
+	Service string
+}
+
+func (err EndpointNotOverriddenError) Error() string {
+	return fmt.Sprintf("refusing to call %s at its AWS endpoint while the endpoints of other services are overridden. Override it too, such as with --endpoint %s=http://localhost:4566", err.Service, err.Service)
+}
@@ -0,0 +1,90 @@
+package externalcreds
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const getCallerIdentityResponse = `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::000000000000:root</Arn>
+    <UserId>000000000000</UserId>
+    <Account>000000000000</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>1</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`
+
+func TestSetEndpointsValidation(t *testing.T) {
+	defer SetEndpoints(nil)
+
+	require.NoError(t, SetEndpoints(map[string]string{"EC2": "http://localhost:4566"}))
+	assert.Equal(t, "http://localhost:4566", Endpoint("EC2"))
+	assert.Equal(t, "", Endpoint("CloudFormation"))
+
+	err := SetEndpoints(map[string]string{"lambda": "http://localhost:4566"})
+	assert.Equal(t, InvalidEndpointError{Service: "lambda", URL: "http://localhost:4566"}, err)
+	err = SetEndpoints(map[string]string{"sts": "localhost"})
+	assert.Equal(t, InvalidEndpointError{Service: "sts", URL: "localhost"}, err)
+
+	// An invalid override leaves the previous ones in place
+	assert.Equal(t, "http://localhost:4566", Endpoint("ec2"))
+}
+
+func TestResolveEndpoint(t *testing.T) {
+	defer SetEndpoints(nil)
+
+	require.NoError(t, SetEndpoints(map[string]string{"cloudcontrol": "http://localhost:4566", "ecr": "http://localhost:4566"}))
+
+	endpoint, err := ResolveEndpoint("cloudcontrolapi", "eu-west-1")
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:4566", endpoint.URL)
+	assert.Equal(t, "eu-west-1", endpoint.SigningRegion)
+
+	endpoint, err = ResolveEndpoint("api.ecr", "eu-west-1")
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:4566", endpoint.URL)
+
+	// Once an endpoint is overridden, the default endpoints of the others are never resolved
+	for _, service := range []string{"ec2", "elasticache", "iam", "organizations", "rds", "redshift", "route53", "s3"} {
+		_, err = ResolveEndpoint(service, "eu-west-1")
+		assert.Equal(t, EndpointNotOverriddenError{Service: service}, err)
+	}
+
+	require.NoError(t, SetEndpoints(nil))
+	endpoint, err = ResolveEndpoint("ec2", "eu-west-1")
+	require.NoError(t, err)
+	assert.Equal(t, "https://ec2.eu-west-1.amazonaws.com", endpoint.URL)
+}
+
+func TestGetUsesEndpointOverrides(t *testing.T) {
+	defer SetEndpoints(nil)
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "text/xml")
+		w.Write([]byte(getCallerIdentityResponse))
+	}))
+	defer server.Close()
+
+	require.NoError(t, SetEndpoints(map[string]string{"sts": server.URL}))
+
+	ctx := WithCredentials(context.Background(), credentials.NewStaticCredentialsProvider("test", "test", ""))
+	config, err := Get(ctx, "us-east-1")
+	require.NoError(t, err)
+
+	output, err := sts.NewFromConfig(config).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	require.NoError(t, err)
+	assert.Equal(t, "000000000000", aws.ToString(output.Account))
+	assert.Equal(t, 1, requests)
+}
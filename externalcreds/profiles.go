@@ -0,0 +1,96 @@
+package externalcreds
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+)
+
+// ListProfiles returns the names of the profiles of the shared AWS config and credentials files that match glob, as
+// matched by filepath.Match, sorted. The files are those of the AWS_CONFIG_FILE and AWS_SHARED_CREDENTIALS_FILE
+// environment variables, or ~/.aws/config and ~/.aws/credentials.
+func ListProfiles(glob string) ([]string, error) {
+	configFile := os.Getenv("AWS_CONFIG_FILE")
+	if configFile == "" {
+		configFile = config.DefaultSharedConfigFilename()
+	}
+	credentialsFile := os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
+	if credentialsFile == "" {
+		credentialsFile = config.DefaultSharedCredentialsFilename()
+	}
+	return listProfiles(glob, configFile, credentialsFile)
+}
+
+func listProfiles(glob, configFile, credentialsFile string) ([]string, error) {
+	// Fail on an invalid glob even when there are no profiles to match
+	if _, err := filepath.Match(glob, ""); err != nil {
+		return nil, err
+	}
+
+	names := map[string]bool{}
+	for _, file := range []struct {
+		path     string
+		isConfig bool
+	}{{configFile, true}, {credentialsFile, false}} {
+		sections, err := readSections(file.path)
+		if err != nil {
+			return nil, err
+		}
+		for _, section := range sections {
+			name, ok := profileName(section, file.isConfig)
+			if !ok {
+				continue
+			}
+			if matches, _ := filepath.Match(glob, name); matches {
+				names[name] = true
+			}
+		}
+	}
+
+	profiles := []string{}
+	for name := range names {
+		profiles = append(profiles, name)
+	}
+	sort.Strings(profiles)
+	return profiles, nil
+}
+
+// profileName returns the profile a section of a shared file defines. Every section of the credentials file is a
+// profile, but the config file names them "profile <name>", except for the default profile, and has other sections,
+// such as sso-session ones.
+func profileName(section string, isConfig bool) (string, bool) {
+	if !isConfig || section == "default" {
+		return section, section != ""
+	}
+	fields := strings.Fields(section)
+	if len(fields) != 2 || fields[0] != "profile" {
+		return "", false
+	}
+	return fields[1], true
+}
+
+// readSections returns the names of the sections of an INI file, or none if the file doesn't exist
+func readSections(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	sections := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			sections = append(sections, strings.TrimSpace(line[1:len(line)-1]))
+		}
+	}
+	return sections, scanner.Err()
+}
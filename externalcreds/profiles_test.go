@@ -0,0 +1,85 @@
+package externalcreds
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfigFile = `[default]
+region = us-east-1
+
+[profile sandbox-a]
+role_arn = arn:aws:iam::111111111111:role/nuke
+source_profile = default
+
+[profile  sandbox-b ]
+sso_session = company
+
+[sso-session company]
+sso_region = us-east-1
+
+[profile prod]
+region = eu-west-1
+`
+
+const testCredentialsFile = `[default]
+aws_access_key_id = AKIA
+
+[sandbox-c]
+aws_access_key_id = AKIA
+
+[sandbox-a]
+aws_access_key_id = AKIA
+`
+
+func TestListProfiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "config")
+	credentialsFile := filepath.Join(dir, "credentials")
+	require.NoError(t, ioutil.WriteFile(configFile, []byte(testConfigFile), 0644))
+	require.NoError(t, ioutil.WriteFile(credentialsFile, []byte(testCredentialsFile), 0644))
+
+	tests := []struct {
+		glob     string
+		expected []string
+	}{
+		{glob: "sandbox-*", expected: []string{"sandbox-a", "sandbox-b", "sandbox-c"}},
+		{glob: "*", expected: []string{"default", "prod", "sandbox-a", "sandbox-b", "sandbox-c"}},
+		{glob: "prod", expected: []string{"prod"}},
+		{glob: "company", expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.glob, func(t *testing.T) {
+			t.Parallel()
+
+			profiles, err := listProfiles(tt.glob, configFile, credentialsFile)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, profiles)
+		})
+	}
+}
+
+func TestListProfilesMissingFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	profiles, err := listProfiles("*", filepath.Join(dir, "config"), filepath.Join(dir, "credentials"))
+	require.NoError(t, err)
+	assert.Empty(t, profiles)
+}
+
+func TestListProfilesInvalidGlob(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	_, err := listProfiles("sandbox-[", filepath.Join(dir, "config"), filepath.Join(dir, "credentials"))
+	assert.Error(t, err)
+}
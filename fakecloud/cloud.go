@@ -0,0 +1,328 @@
+// Package fakecloud is an in-memory stand-in for the parts of AWS that cloud-nuke calls: Cloud Control,
+// CloudFormation's ListTypes and DescribeType, EC2's DescribeRegions, STS, and the service APIs behind the
+// CloudNuke::EC2:: types and the preparation of buckets, repositories and hosted zones. A Cloud is an aws.ClientFactory, so scans, nukes and
+// restores run against it, deterministically and without a network, when it is passed to aws.WithClientFactory.
+// Failures, throttling and latency can be injected to test how cloud-nuke retries and reports them.
+package fakecloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/gruntwork-io/cloud-nuke/aws"
+)
+
+// Opt-in statuses of regions, as returned by EC2 DescribeRegions
+const (
+	OptInNotRequired = aws.RegionOptInNotRequired
+	OptedIn          = aws.RegionOptedIn
+	NotOptedIn       = aws.RegionNotOptedIn
+)
+
+// defaultPageSize is the number of items listed per page when the request doesn't set MaxResults
+const defaultPageSize = 100
+
+// Region is a region of the fake cloud
+type Region struct {
+	Name string
+	// OptInStatus is one of OptInNotRequired, OptedIn or NotOptedIn. Regions that are NotOptedIn are only returned by
+	// DescribeRegions when AllRegions is set. Defaults to OptInNotRequired.
+	OptInStatus string
+}
+
+// enabled returns true if DescribeRegions returns the region without AllRegions
+func (region Region) enabled() bool {
+	return region.OptInStatus != NotOptedIn
+}
+
+// Type is a resource type that the fake cloud lists with ListTypes and describes with DescribeType
+type Type struct {
+	Name string
+	// PrimaryIdentifier is the property whose value identifies the resources created with CreateResource. Resources
+	// whose desired state doesn't set it get a generated identifier.
+	PrimaryIdentifier string
+	// Schema is the JSON schema returned by DescribeType. Defaults to a schema with only the type name and primary
+	// identifier.
+	Schema string
+}
+
+// schema returns the schema that DescribeType returns for the type
+func (t Type) schema() string {
+	if t.Schema != "" {
+		return t.Schema
+	}
+	schema := map[string]interface{}{"typeName": t.Name}
+	if t.PrimaryIdentifier != "" {
+		schema["primaryIdentifier"] = []string{"/properties/" + t.PrimaryIdentifier}
+	}
+	encoded, _ := json.Marshal(schema)
+	return string(encoded)
+}
+
+// Resource is a resource of the fake cloud
+type Resource struct {
+	TypeName   string
+	Identifier string
+	Properties map[string]interface{}
+	// DependsOn are the identifiers of resources, in the same region, that this resource uses. A resource can't be
+	// deleted while a resource that depends on it exists: the deletion fails with ResourceConflict.
+	DependsOn []string
+	// DeleteFailure, when set, makes the deletion of the resource fail with this handler error code
+	DeleteFailure types.HandlerErrorCode
+}
+
+// propertiesJSON returns the properties of the resource as Cloud Control returns them
+func (resource Resource) propertiesJSON() string {
+	properties := resource.Properties
+	if properties == nil {
+		properties = map[string]interface{}{}
+	}
+	encoded, _ := json.Marshal(properties)
+	return string(encoded)
+}
+
+// Call is a call made to the fake cloud, recorded in the order the calls were made
+type Call struct {
+	Operation  string
+	Region     string
+	TypeName   string
+	Identifier string
+	Err        error
+}
+
+// Cloud is the state of the fake cloud: its regions, resource types, resources and pending requests. It is safe for
+// concurrent use.
+type Cloud struct {
+	// Latency is how long every call takes. Calls return early with the context's error if it is cancelled.
+	Latency time.Duration
+	// PollsToComplete is the number of GetResourceRequestStatus calls that return IN_PROGRESS before a request
+	// completes. With 0, requests complete as soon as they are submitted.
+	PollsToComplete int
+	// PageSize is the number of items per page of ListResources and ListTypes when MaxResults isn't set, and of the
+	// paginated operations of the service APIs
+	PageSize int
+	// Account is the account that STS and IAM return. Its ID defaults to DefaultAccountID, and its caller ARN to a
+	// user of the account.
+	Account aws.AccountIdentity
+
+	mutex     sync.Mutex
+	regions   []Region
+	types     map[string]Type
+	resources map[string][]*Resource
+	requests  map[string]*request
+	// clientTokens are the request tokens of requests submitted with a client token, by client token
+	clientTokens map[string]string
+	faults       []*Fault
+	calls        []Call
+	nextID       int
+}
+
+// New returns an empty fake cloud with the given regions, which don't need to be opted in to
+func New(regions ...string) *Cloud {
+	cloud := &Cloud{
+		PageSize:     defaultPageSize,
+		types:        map[string]Type{},
+		resources:    map[string][]*Resource{},
+		requests:     map[string]*request{},
+		clientTokens: map[string]string{},
+	}
+	for _, region := range regions {
+		cloud.AddRegion(Region{Name: region})
+	}
+	return cloud
+}
+
+// AddRegion adds a region, or replaces the region of the same name
+func (cloud *Cloud) AddRegion(region Region) {
+	if region.OptInStatus == "" {
+		region.OptInStatus = OptInNotRequired
+	}
+
+	cloud.mutex.Lock()
+	defer cloud.mutex.Unlock()
+
+	for i, existing := range cloud.regions {
+		if existing.Name == region.Name {
+			cloud.regions[i] = region
+			return
+		}
+	}
+	cloud.regions = append(cloud.regions, region)
+}
+
+// AddType adds a resource type, or replaces the type of the same name
+func (cloud *Cloud) AddType(t Type) {
+	cloud.mutex.Lock()
+	defer cloud.mutex.Unlock()
+	cloud.types[t.Name] = t
+}
+
+// AddResource adds a resource to a region. Its type is added too if it's missing, unless it is a CloudNuke:: type,
+// which CloudFormation doesn't list.
+func (cloud *Cloud) AddResource(region string, resource Resource) {
+	cloud.mutex.Lock()
+	defer cloud.mutex.Unlock()
+
+	if _, ok := cloud.types[resource.TypeName]; !ok && !strings.HasPrefix(resource.TypeName, aws.CustomResourceTypePrefix) {
+		cloud.types[resource.TypeName] = Type{Name: resource.TypeName}
+	}
+	cloud.resources[region] = append(cloud.resources[region], &resource)
+}
+
+// Resources returns copies of the resources that remain in a region, in the order they were added
+func (cloud *Cloud) Resources(region string) []Resource {
+	cloud.mutex.Lock()
+	defer cloud.mutex.Unlock()
+
+	resources := []Resource{}
+	for _, resource := range cloud.resources[region] {
+		resources = append(resources, *resource)
+	}
+	return resources
+}
+
+// Exists returns true if the resource is in the region
+func (cloud *Cloud) Exists(region, typeName, identifier string) bool {
+	cloud.mutex.Lock()
+	defer cloud.mutex.Unlock()
+	return cloud.find(region, typeName, identifier) != nil
+}
+
+// Calls returns the calls made so far, in order
+func (cloud *Cloud) Calls() []Call {
+	cloud.mutex.Lock()
+	defer cloud.mutex.Unlock()
+	return append([]Call{}, cloud.calls...)
+}
+
+// CallsTo returns the calls made so far to an operation, such as "DeleteResource", in order
+func (cloud *Cloud) CallsTo(operation string) []Call {
+	calls := []Call{}
+	for _, call := range cloud.Calls() {
+		if call.Operation == operation {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// CloudControl returns a Cloud Control client of the region of config
+func (cloud *Cloud) CloudControl(config awsgo.Config) aws.CloudControlAPI {
+	return &CloudControl{cloud: cloud, region: config.Region}
+}
+
+// CloudFormation returns a CloudFormation client of the region of config
+func (cloud *Cloud) CloudFormation(config awsgo.Config) aws.CloudFormationAPI {
+	return &CloudFormation{cloud: cloud, region: config.Region}
+}
+
+// EC2 returns an EC2 client of the region of config
+func (cloud *Cloud) EC2(config awsgo.Config) aws.EC2API {
+	return &EC2{cloud: cloud, region: config.Region}
+}
+
+// STS returns an STS client of the region of config
+func (cloud *Cloud) STS(config awsgo.Config) aws.STSAPI {
+	return &STS{cloud: cloud, region: config.Region}
+}
+
+// Services returns the aws-sdk-go (v1) clients of the region of config. Organizations is not simulated, so it is nil.
+func (cloud *Cloud) Services(config awsgo.Config) (*aws.ServiceClients, error) {
+	return &aws.ServiceClients{
+		EC2:         &EC2Service{cloud: cloud, region: config.Region},
+		ECR:         &ECR{cloud: cloud, region: config.Region},
+		ElastiCache: &ElastiCache{cloud: cloud, region: config.Region},
+		IAM:         &IAM{cloud: cloud, region: config.Region},
+		RDS:         &RDS{cloud: cloud, region: config.Region},
+		Redshift:    &Redshift{cloud: cloud, region: config.Region},
+		Route53:     &Route53{cloud: cloud, region: config.Region},
+		S3:          &S3{cloud: cloud, region: config.Region},
+	}, nil
+}
+
+// call waits for the latency of the cloud, then returns the error of the first injected fault that matches the call,
+// if any, recording the call. Clients call it before doing anything else, without holding the mutex.
+func (cloud *Cloud) call(ctx context.Context, operation, region, typeName, identifier string) error {
+	if cloud.Latency > 0 {
+		timer := time.NewTimer(cloud.Latency)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	cloud.mutex.Lock()
+	defer cloud.mutex.Unlock()
+
+	var err error
+	for _, fault := range cloud.faults {
+		if fault.matches(operation, region, typeName, identifier) {
+			err = fault.trigger()
+			break
+		}
+	}
+	cloud.calls = append(cloud.calls, Call{Operation: operation, Region: region, TypeName: typeName, Identifier: identifier, Err: err})
+	return err
+}
+
+// find returns the resource, or nil if it is not in the region. The mutex must be held.
+func (cloud *Cloud) find(region, typeName, identifier string) *Resource {
+	for _, resource := range cloud.resources[region] {
+		if resource.TypeName == typeName && resource.Identifier == identifier {
+			return resource
+		}
+	}
+	return nil
+}
+
+// dependents returns the identifiers of the resources of the region that depend on identifier, sorted. The mutex
+// must be held.
+func (cloud *Cloud) dependents(region, identifier string) []string {
+	dependents := []string{}
+	for _, resource := range cloud.resources[region] {
+		for _, dependency := range resource.DependsOn {
+			if dependency == identifier {
+				dependents = append(dependents, resource.Identifier)
+			}
+		}
+	}
+	sort.Strings(dependents)
+	return dependents
+}
+
+// remove removes the resource from the region. The mutex must be held.
+func (cloud *Cloud) remove(region, typeName, identifier string) {
+	resources := cloud.resources[region]
+	for i, resource := range resources {
+		if resource.TypeName == typeName && resource.Identifier == identifier {
+			cloud.resources[region] = append(resources[:i:i], resources[i+1:]...)
+			return
+		}
+	}
+}
+
+// generateID returns a new identifier with the given prefix. The mutex must be held.
+func (cloud *Cloud) generateID(prefix string) string {
+	cloud.nextID++
+	return fmt.Sprintf("%s-%d", prefix, cloud.nextID)
+}
+
+// pageSize returns the number of items of a page of a list operation
+func (cloud *Cloud) pageSize(maxResults *int32) int {
+	if maxResults != nil && *maxResults > 0 {
+		return int(*maxResults)
+	}
+	if cloud.PageSize > 0 {
+		return cloud.PageSize
+	}
+	return defaultPageSize
+}
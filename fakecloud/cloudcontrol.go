@@ -0,0 +1,458 @@
+package fakecloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+)
+
+// request is a resource operation request of Cloud Control. It stays IN_PROGRESS until it is polled
+// Cloud.PollsToComplete times, then resolves to its outcome.
+type request struct {
+	region string
+	event  types.ProgressEvent
+	// resolve carries out the operation and returns its final progress event. The mutex must be held.
+	resolve func() types.ProgressEvent
+	// pollsLeft is the number of GetResourceRequestStatus calls that still return IN_PROGRESS
+	pollsLeft int
+}
+
+// done returns true if the request reached a terminal status
+func (req *request) done() bool {
+	return req.resolve == nil
+}
+
+// complete carries out the request. The mutex must be held.
+func (req *request) complete() {
+	if req.done() {
+		return
+	}
+	outcome := req.resolve()
+	outcome.RequestToken = req.event.RequestToken
+	outcome.Operation = req.event.Operation
+	outcome.TypeName = req.event.TypeName
+	if outcome.Identifier == nil {
+		outcome.Identifier = req.event.Identifier
+	}
+	outcome.EventTime = awsgo.Time(time.Now())
+	req.event = outcome
+	req.resolve = nil
+}
+
+// CloudControl is a fake Cloud Control client of a region of a Cloud
+type CloudControl struct {
+	cloud  *Cloud
+	region string
+}
+
+func (client *CloudControl) ListResources(ctx context.Context, params *cloudcontrol.ListResourcesInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.ListResourcesOutput, error) {
+	typeName := awsgo.ToString(params.TypeName)
+	if err := client.cloud.call(ctx, "ListResources", client.region, typeName, ""); err != nil {
+		return nil, err
+	}
+
+	client.cloud.mutex.Lock()
+	defer client.cloud.mutex.Unlock()
+
+	descriptions := []types.ResourceDescription{}
+	for _, resource := range client.cloud.resources[client.region] {
+		if resource.TypeName == typeName {
+			descriptions = append(descriptions, types.ResourceDescription{
+				Identifier: awsgo.String(resource.Identifier),
+				Properties: awsgo.String(resource.propertiesJSON()),
+			})
+		}
+	}
+
+	start, end, nextToken, err := page(len(descriptions), params.NextToken, client.cloud.pageSize(params.MaxResults))
+	if err != nil {
+		return nil, err
+	}
+	return &cloudcontrol.ListResourcesOutput{
+		TypeName:             params.TypeName,
+		ResourceDescriptions: descriptions[start:end],
+		NextToken:            nextToken,
+	}, nil
+}
+
+func (client *CloudControl) GetResource(ctx context.Context, params *cloudcontrol.GetResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.GetResourceOutput, error) {
+	typeName, identifier := awsgo.ToString(params.TypeName), awsgo.ToString(params.Identifier)
+	if err := client.cloud.call(ctx, "GetResource", client.region, typeName, identifier); err != nil {
+		return nil, err
+	}
+
+	client.cloud.mutex.Lock()
+	defer client.cloud.mutex.Unlock()
+
+	resource := client.cloud.find(client.region, typeName, identifier)
+	if resource == nil {
+		return nil, resourceNotFound(typeName, identifier)
+	}
+	return &cloudcontrol.GetResourceOutput{
+		TypeName: params.TypeName,
+		ResourceDescription: &types.ResourceDescription{
+			Identifier: awsgo.String(resource.Identifier),
+			Properties: awsgo.String(resource.propertiesJSON()),
+		},
+	}, nil
+}
+
+func (client *CloudControl) CreateResource(ctx context.Context, params *cloudcontrol.CreateResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.CreateResourceOutput, error) {
+	typeName := awsgo.ToString(params.TypeName)
+	if err := client.cloud.call(ctx, "CreateResource", client.region, typeName, ""); err != nil {
+		return nil, err
+	}
+
+	properties := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(awsgo.ToString(params.DesiredState)), &properties); err != nil {
+		return nil, &types.InvalidRequestException{Message: awsgo.String(fmt.Sprintf("DesiredState is not a JSON object: %s", err))}
+	}
+
+	client.cloud.mutex.Lock()
+	defer client.cloud.mutex.Unlock()
+
+	identifier, _ := properties[client.cloud.types[typeName].PrimaryIdentifier].(string)
+	if identifier == "" {
+		identifier = client.cloud.generateID(strings.ToLower(typeName[strings.LastIndex(typeName, ":")+1:]))
+	}
+
+	event, err := client.cloud.submit(client.region, params.ClientToken, types.OperationCreate, typeName, identifier, func() types.ProgressEvent {
+		if client.cloud.find(client.region, typeName, identifier) != nil {
+			return failed(types.HandlerErrorCodeAlreadyExists, fmt.Sprintf("%s %s already exists", typeName, identifier))
+		}
+		if _, ok := client.cloud.types[typeName]; !ok {
+			client.cloud.types[typeName] = Type{Name: typeName}
+		}
+		client.cloud.resources[client.region] = append(client.cloud.resources[client.region], &Resource{
+			TypeName:   typeName,
+			Identifier: identifier,
+			Properties: properties,
+		})
+		return succeeded()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &cloudcontrol.CreateResourceOutput{ProgressEvent: event}, nil
+}
+
+func (client *CloudControl) UpdateResource(ctx context.Context, params *cloudcontrol.UpdateResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.UpdateResourceOutput, error) {
+	typeName, identifier := awsgo.ToString(params.TypeName), awsgo.ToString(params.Identifier)
+	if err := client.cloud.call(ctx, "UpdateResource", client.region, typeName, identifier); err != nil {
+		return nil, err
+	}
+
+	patch := []patchOperation{}
+	if err := json.Unmarshal([]byte(awsgo.ToString(params.PatchDocument)), &patch); err != nil {
+		return nil, &types.InvalidRequestException{Message: awsgo.String(fmt.Sprintf("PatchDocument is not a JSON Patch: %s", err))}
+	}
+
+	client.cloud.mutex.Lock()
+	defer client.cloud.mutex.Unlock()
+
+	if client.cloud.find(client.region, typeName, identifier) == nil {
+		return nil, resourceNotFound(typeName, identifier)
+	}
+
+	event, err := client.cloud.submit(client.region, params.ClientToken, types.OperationUpdate, typeName, identifier, func() types.ProgressEvent {
+		resource := client.cloud.find(client.region, typeName, identifier)
+		if resource == nil {
+			return failed(types.HandlerErrorCodeNotFound, fmt.Sprintf("%s %s was not found", typeName, identifier))
+		}
+		properties, err := applyPatch(resource.Properties, patch)
+		if err != nil {
+			return failed(types.HandlerErrorCodeInvalidRequest, err.Error())
+		}
+		resource.Properties = properties
+		return succeeded()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &cloudcontrol.UpdateResourceOutput{ProgressEvent: event}, nil
+}
+
+func (client *CloudControl) DeleteResource(ctx context.Context, params *cloudcontrol.DeleteResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.DeleteResourceOutput, error) {
+	typeName, identifier := awsgo.ToString(params.TypeName), awsgo.ToString(params.Identifier)
+	if err := client.cloud.call(ctx, "DeleteResource", client.region, typeName, identifier); err != nil {
+		return nil, err
+	}
+
+	client.cloud.mutex.Lock()
+	defer client.cloud.mutex.Unlock()
+
+	if _, ok := client.cloud.clientTokens[awsgo.ToString(params.ClientToken)]; !ok && client.cloud.find(client.region, typeName, identifier) == nil {
+		return nil, resourceNotFound(typeName, identifier)
+	}
+
+	event, err := client.cloud.submit(client.region, params.ClientToken, types.OperationDelete, typeName, identifier, func() types.ProgressEvent {
+		resource := client.cloud.find(client.region, typeName, identifier)
+		if resource == nil {
+			return failed(types.HandlerErrorCodeNotFound, fmt.Sprintf("%s %s was not found", typeName, identifier))
+		}
+		if resource.DeleteFailure != "" {
+			return failed(resource.DeleteFailure, fmt.Sprintf("Deletion of %s %s failed with %s", typeName, identifier, resource.DeleteFailure))
+		}
+		if dependents := client.cloud.dependents(client.region, identifier); len(dependents) > 0 {
+			return failed(types.HandlerErrorCodeResourceConflict, fmt.Sprintf("%s %s is in use by %s", typeName, identifier, strings.Join(dependents, ", ")))
+		}
+		client.cloud.remove(client.region, typeName, identifier)
+		return succeeded()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &cloudcontrol.DeleteResourceOutput{ProgressEvent: event}, nil
+}
+
+func (client *CloudControl) GetResourceRequestStatus(ctx context.Context, params *cloudcontrol.GetResourceRequestStatusInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.GetResourceRequestStatusOutput, error) {
+	if err := client.cloud.call(ctx, "GetResourceRequestStatus", client.region, "", ""); err != nil {
+		return nil, err
+	}
+
+	client.cloud.mutex.Lock()
+	defer client.cloud.mutex.Unlock()
+
+	req, err := client.cloud.request(params.RequestToken)
+	if err != nil {
+		return nil, err
+	}
+	if !req.done() {
+		if req.pollsLeft > 0 {
+			req.pollsLeft--
+		} else {
+			req.complete()
+		}
+	}
+
+	event := req.event
+	return &cloudcontrol.GetResourceRequestStatusOutput{ProgressEvent: &event}, nil
+}
+
+func (client *CloudControl) CancelResourceRequest(ctx context.Context, params *cloudcontrol.CancelResourceRequestInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.CancelResourceRequestOutput, error) {
+	if err := client.cloud.call(ctx, "CancelResourceRequest", client.region, "", ""); err != nil {
+		return nil, err
+	}
+
+	client.cloud.mutex.Lock()
+	defer client.cloud.mutex.Unlock()
+
+	req, err := client.cloud.request(params.RequestToken)
+	if err != nil {
+		return nil, err
+	}
+	if req.done() {
+		return nil, &types.ConcurrentModificationException{Message: awsgo.String(fmt.Sprintf("Request %s already completed", awsgo.ToString(params.RequestToken)))}
+	}
+
+	req.event.OperationStatus = types.OperationStatusCancelComplete
+	req.event.EventTime = awsgo.Time(time.Now())
+	req.resolve = nil
+
+	event := req.event
+	return &cloudcontrol.CancelResourceRequestOutput{ProgressEvent: &event}, nil
+}
+
+// submit starts a request, or returns the request already submitted with the same client token, which is how Cloud
+// Control makes retries idempotent. The request is carried out right away if PollsToComplete is 0, but, like Cloud
+// Control, submit always returns the IN_PROGRESS event. The mutex must be held.
+func (cloud *Cloud) submit(region string, clientToken *string, operation types.Operation, typeName, identifier string, resolve func() types.ProgressEvent) (*types.ProgressEvent, error) {
+	if token := awsgo.ToString(clientToken); token != "" {
+		if requestToken, ok := cloud.clientTokens[token]; ok {
+			req := cloud.requests[requestToken]
+			if req.region != region || req.event.Operation != operation || awsgo.ToString(req.event.TypeName) != typeName || awsgo.ToString(req.event.Identifier) != identifier {
+				return nil, &types.ClientTokenConflictException{Message: awsgo.String(fmt.Sprintf("Client token %s was used by request %s", token, requestToken))}
+			}
+			event := req.event
+			event.OperationStatus = types.OperationStatusInProgress
+			return &event, nil
+		}
+	}
+
+	for _, req := range cloud.requests {
+		if !req.done() && req.region == region && awsgo.ToString(req.event.TypeName) == typeName && awsgo.ToString(req.event.Identifier) == identifier {
+			return nil, &types.ConcurrentOperationException{Message: awsgo.String(fmt.Sprintf("Request %s is in progress for %s %s", awsgo.ToString(req.event.RequestToken), typeName, identifier))}
+		}
+	}
+
+	requestToken := cloud.generateID("request")
+	req := &request{
+		region: region,
+		event: types.ProgressEvent{
+			RequestToken:    awsgo.String(requestToken),
+			Operation:       operation,
+			OperationStatus: types.OperationStatusInProgress,
+			TypeName:        awsgo.String(typeName),
+			Identifier:      awsgo.String(identifier),
+			EventTime:       awsgo.Time(time.Now()),
+		},
+		resolve:   resolve,
+		pollsLeft: cloud.PollsToComplete,
+	}
+	cloud.requests[requestToken] = req
+	if token := awsgo.ToString(clientToken); token != "" {
+		cloud.clientTokens[token] = requestToken
+	}
+
+	submitted := req.event
+	if cloud.PollsToComplete <= 0 {
+		req.complete()
+	}
+	return &submitted, nil
+}
+
+// request returns the request of a request token. The mutex must be held.
+func (cloud *Cloud) request(requestToken *string) (*request, error) {
+	req, ok := cloud.requests[awsgo.ToString(requestToken)]
+	if !ok {
+		return nil, &types.RequestTokenNotFoundException{Message: awsgo.String(fmt.Sprintf("Request %s was not found", awsgo.ToString(requestToken)))}
+	}
+	return req, nil
+}
+
+func succeeded() types.ProgressEvent {
+	return types.ProgressEvent{OperationStatus: types.OperationStatusSuccess}
+}
+
+func failed(code types.HandlerErrorCode, message string) types.ProgressEvent {
+	return types.ProgressEvent{
+		OperationStatus: types.OperationStatusFailed,
+		ErrorCode:       code,
+		StatusMessage:   awsgo.String(message),
+	}
+}
+
+func resourceNotFound(typeName, identifier string) error {
+	return &types.ResourceNotFoundException{Message: awsgo.String(fmt.Sprintf("%s %s was not found", typeName, identifier))}
+}
+
+// page returns the bounds of the page of a list of length items that starts at nextToken, and the token of the next
+// page, or nil for the last page
+func page(length int, nextToken *string, pageSize int) (int, int, *string, error) {
+	start := 0
+	if token := awsgo.ToString(nextToken); token != "" {
+		parsed, err := strconv.Atoi(token)
+		if err != nil || parsed < 0 || parsed > length {
+			return 0, 0, nil, &types.InvalidRequestException{Message: awsgo.String(fmt.Sprintf("Invalid NextToken %s", token))}
+		}
+		start = parsed
+	}
+
+	end := start + pageSize
+	if end >= length {
+		return start, length, nil, nil
+	}
+	return start, end, awsgo.String(strconv.Itoa(end)), nil
+}
+
+// patchOperation is a single RFC 6902 JSON Patch operation. The add, replace and remove operations are supported.
+type patchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+// applyPatch returns a copy of properties with the patch applied
+func applyPatch(properties map[string]interface{}, patch []patchOperation) (map[string]interface{}, error) {
+	// Round trip through JSON to deep copy, and to get the same types as the values of the patch
+	encoded, err := json.Marshal(properties)
+	if err != nil {
+		return nil, err
+	}
+	var document interface{} = map[string]interface{}{}
+	if err := json.Unmarshal(encoded, &document); err != nil {
+		return nil, err
+	}
+	if document == nil {
+		document = map[string]interface{}{}
+	}
+
+	for _, operation := range patch {
+		if operation.Op != "add" && operation.Op != "replace" && operation.Op != "remove" {
+			return nil, fmt.Errorf("unsupported patch operation %q", operation.Op)
+		}
+		if !strings.HasPrefix(operation.Path, "/") {
+			return nil, fmt.Errorf("invalid patch path %q", operation.Path)
+		}
+		tokens := strings.Split(operation.Path[1:], "/")
+		for i, token := range tokens {
+			tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		}
+		if document, err = patchValue(document, tokens, operation); err != nil {
+			return nil, err
+		}
+	}
+
+	patched, ok := document.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("patch replaced the properties with a %T", document)
+	}
+	return patched, nil
+}
+
+// patchValue applies operation to the value at the path of tokens within node, returning the updated node
+func patchValue(node interface{}, tokens []string, operation patchOperation) (interface{}, error) {
+	key, last := tokens[0], len(tokens) == 1
+
+	switch container := node.(type) {
+	case map[string]interface{}:
+		child, exists := container[key]
+		if !last {
+			if !exists {
+				return nil, fmt.Errorf("patch path %q does not exist", operation.Path)
+			}
+			patched, err := patchValue(child, tokens[1:], operation)
+			if err != nil {
+				return nil, err
+			}
+			container[key] = patched
+			return container, nil
+		}
+
+		switch {
+		case operation.Op == "remove" && exists:
+			delete(container, key)
+		case operation.Op == "replace" && exists, operation.Op == "add":
+			container[key] = operation.Value
+		default:
+			return nil, fmt.Errorf("patch path %q does not exist", operation.Path)
+		}
+		return container, nil
+
+	case []interface{}:
+		if last && operation.Op == "add" && key == "-" {
+			return append(container, operation.Value), nil
+		}
+		index, err := strconv.Atoi(key)
+		if err != nil || index < 0 || index > len(container) || (index == len(container) && !(last && operation.Op == "add")) {
+			return nil, fmt.Errorf("patch path %q does not exist", operation.Path)
+		}
+		if !last {
+			patched, err := patchValue(container[index], tokens[1:], operation)
+			if err != nil {
+				return nil, err
+			}
+			container[index] = patched
+			return container, nil
+		}
+
+		switch operation.Op {
+		case "add":
+			container = append(container[:index], append([]interface{}{operation.Value}, container[index:]...)...)
+		case "replace":
+			container[index] = operation.Value
+		case "remove":
+			container = append(container[:index], container[index+1:]...)
+		}
+		return container, nil
+	}
+
+	return nil, fmt.Errorf("patch path %q does not exist", operation.Path)
+}
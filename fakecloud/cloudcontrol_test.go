@@ -0,0 +1,284 @@
+package fakecloud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRegion  = "us-east-1"
+	logGroup    = "AWS::Logs::LogGroup"
+	vpcType     = "AWS::EC2::VPC"
+	subnetType  = "AWS::EC2::Subnet"
+	bucketType  = "AWS::S3::Bucket"
+	testTimeout = 30 * time.Second
+)
+
+func cloudControl(cloud *Cloud) *CloudControl {
+	return cloud.CloudControl(awsgo.Config{Region: testRegion}).(*CloudControl)
+}
+
+func deleteInput(typeName, identifier, clientToken string) *cloudcontrol.DeleteResourceInput {
+	input := &cloudcontrol.DeleteResourceInput{TypeName: awsgo.String(typeName), Identifier: awsgo.String(identifier)}
+	if clientToken != "" {
+		input.ClientToken = awsgo.String(clientToken)
+	}
+	return input
+}
+
+func requestStatus(t *testing.T, client *CloudControl, requestToken *string) *types.ProgressEvent {
+	output, err := client.GetResourceRequestStatus(context.Background(), &cloudcontrol.GetResourceRequestStatusInput{RequestToken: requestToken})
+	require.NoError(t, err)
+	return output.ProgressEvent
+}
+
+func TestListResourcesPaginates(t *testing.T) {
+	t.Parallel()
+
+	cloud := New(testRegion)
+	cloud.PageSize = 2
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		cloud.AddResource(testRegion, Resource{TypeName: logGroup, Identifier: name})
+	}
+	cloud.AddResource(testRegion, Resource{TypeName: bucketType, Identifier: "bucket"})
+	cloud.AddResource("eu-west-1", Resource{TypeName: logGroup, Identifier: "elsewhere"})
+
+	identifiers := []string{}
+	pages := 0
+	paginator := cloudcontrol.NewListResourcesPaginator(cloudControl(cloud), &cloudcontrol.ListResourcesInput{TypeName: awsgo.String(logGroup)})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.Background())
+		require.NoError(t, err)
+		for _, description := range output.ResourceDescriptions {
+			identifiers = append(identifiers, awsgo.ToString(description.Identifier))
+		}
+		pages++
+	}
+
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, identifiers)
+	assert.Equal(t, 3, pages)
+}
+
+func TestGetResourceReturnsProperties(t *testing.T) {
+	t.Parallel()
+
+	cloud := New(testRegion)
+	cloud.AddResource(testRegion, Resource{TypeName: bucketType, Identifier: "bucket", Properties: map[string]interface{}{"BucketName": "bucket"}})
+	client := cloudControl(cloud)
+
+	output, err := client.GetResource(context.Background(), &cloudcontrol.GetResourceInput{TypeName: awsgo.String(bucketType), Identifier: awsgo.String("bucket")})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"BucketName": "bucket"}`, awsgo.ToString(output.ResourceDescription.Properties))
+
+	_, err = client.GetResource(context.Background(), &cloudcontrol.GetResourceInput{TypeName: awsgo.String(bucketType), Identifier: awsgo.String("missing")})
+	var notFound *types.ResourceNotFoundException
+	assert.ErrorAs(t, err, &notFound)
+}
+
+func TestDeleteResourceCompletesAfterPolls(t *testing.T) {
+	t.Parallel()
+
+	cloud := New(testRegion)
+	cloud.PollsToComplete = 2
+	cloud.AddResource(testRegion, Resource{TypeName: logGroup, Identifier: "group"})
+	client := cloudControl(cloud)
+
+	output, err := client.DeleteResource(context.Background(), deleteInput(logGroup, "group", ""))
+	require.NoError(t, err)
+	assert.Equal(t, types.OperationDelete, output.ProgressEvent.Operation)
+	assert.Equal(t, types.OperationStatusInProgress, output.ProgressEvent.OperationStatus)
+
+	for poll := 0; poll < 2; poll++ {
+		assert.Equal(t, types.OperationStatusInProgress, requestStatus(t, client, output.ProgressEvent.RequestToken).OperationStatus)
+		assert.True(t, cloud.Exists(testRegion, logGroup, "group"))
+	}
+	assert.Equal(t, types.OperationStatusSuccess, requestStatus(t, client, output.ProgressEvent.RequestToken).OperationStatus)
+	assert.False(t, cloud.Exists(testRegion, logGroup, "group"))
+}
+
+func TestDeleteResourceIsIdempotentWithClientToken(t *testing.T) {
+	t.Parallel()
+
+	cloud := New(testRegion)
+	cloud.PollsToComplete = 1
+	cloud.AddResource(testRegion, Resource{TypeName: logGroup, Identifier: "group"})
+	cloud.AddResource(testRegion, Resource{TypeName: logGroup, Identifier: "other"})
+	client := cloudControl(cloud)
+
+	first, err := client.DeleteResource(context.Background(), deleteInput(logGroup, "group", "token"))
+	require.NoError(t, err)
+	second, err := client.DeleteResource(context.Background(), deleteInput(logGroup, "group", "token"))
+	require.NoError(t, err)
+	assert.Equal(t, first.ProgressEvent.RequestToken, second.ProgressEvent.RequestToken)
+
+	_, err = client.DeleteResource(context.Background(), deleteInput(logGroup, "group", "another-token"))
+	var concurrentOperation *types.ConcurrentOperationException
+	assert.ErrorAs(t, err, &concurrentOperation)
+
+	_, err = client.DeleteResource(context.Background(), deleteInput(logGroup, "other", "token"))
+	var conflict *types.ClientTokenConflictException
+	assert.ErrorAs(t, err, &conflict)
+}
+
+func TestDeleteResourceFailures(t *testing.T) {
+	t.Parallel()
+
+	cloud := New(testRegion)
+	cloud.AddResource(testRegion, Resource{TypeName: vpcType, Identifier: "vpc-1"})
+	cloud.AddResource(testRegion, Resource{TypeName: subnetType, Identifier: "subnet-1", DependsOn: []string{"vpc-1"}})
+	cloud.AddResource(testRegion, Resource{TypeName: bucketType, Identifier: "bucket"})
+	cloud.FailDeletion(testRegion, bucketType, "bucket", types.HandlerErrorCodeAccessDenied)
+	client := cloudControl(cloud)
+
+	_, err := client.DeleteResource(context.Background(), deleteInput(logGroup, "missing", ""))
+	var notFound *types.ResourceNotFoundException
+	assert.ErrorAs(t, err, &notFound)
+
+	output, err := client.DeleteResource(context.Background(), deleteInput(vpcType, "vpc-1", ""))
+	require.NoError(t, err)
+	event := requestStatus(t, client, output.ProgressEvent.RequestToken)
+	assert.Equal(t, types.OperationStatusFailed, event.OperationStatus)
+	assert.Equal(t, types.HandlerErrorCodeResourceConflict, event.ErrorCode)
+	assert.Contains(t, awsgo.ToString(event.StatusMessage), "subnet-1")
+	assert.True(t, cloud.Exists(testRegion, vpcType, "vpc-1"))
+
+	output, err = client.DeleteResource(context.Background(), deleteInput(bucketType, "bucket", ""))
+	require.NoError(t, err)
+	event = requestStatus(t, client, output.ProgressEvent.RequestToken)
+	assert.Equal(t, types.OperationStatusFailed, event.OperationStatus)
+	assert.Equal(t, types.HandlerErrorCodeAccessDenied, event.ErrorCode)
+
+	// Once the subnet is gone, the VPC can be deleted
+	for _, resource := range []*cloudcontrol.DeleteResourceInput{deleteInput(subnetType, "subnet-1", ""), deleteInput(vpcType, "vpc-1", "")} {
+		output, err := client.DeleteResource(context.Background(), resource)
+		require.NoError(t, err)
+		assert.Equal(t, types.OperationStatusSuccess, requestStatus(t, client, output.ProgressEvent.RequestToken).OperationStatus)
+	}
+	assert.Equal(t, []Resource{{TypeName: bucketType, Identifier: "bucket", DeleteFailure: types.HandlerErrorCodeAccessDenied}}, cloud.Resources(testRegion))
+}
+
+func TestCancelResourceRequest(t *testing.T) {
+	t.Parallel()
+
+	cloud := New(testRegion)
+	cloud.PollsToComplete = 5
+	cloud.AddResource(testRegion, Resource{TypeName: logGroup, Identifier: "group"})
+	client := cloudControl(cloud)
+
+	output, err := client.DeleteResource(context.Background(), deleteInput(logGroup, "group", ""))
+	require.NoError(t, err)
+
+	cancelled, err := client.CancelResourceRequest(context.Background(), &cloudcontrol.CancelResourceRequestInput{RequestToken: output.ProgressEvent.RequestToken})
+	require.NoError(t, err)
+	assert.Equal(t, types.OperationStatusCancelComplete, cancelled.ProgressEvent.OperationStatus)
+	assert.Equal(t, types.OperationStatusCancelComplete, requestStatus(t, client, output.ProgressEvent.RequestToken).OperationStatus)
+	assert.True(t, cloud.Exists(testRegion, logGroup, "group"))
+
+	_, err = client.CancelResourceRequest(context.Background(), &cloudcontrol.CancelResourceRequestInput{RequestToken: output.ProgressEvent.RequestToken})
+	var concurrentModification *types.ConcurrentModificationException
+	assert.ErrorAs(t, err, &concurrentModification)
+
+	_, err = client.GetResourceRequestStatus(context.Background(), &cloudcontrol.GetResourceRequestStatusInput{RequestToken: awsgo.String("unknown")})
+	var tokenNotFound *types.RequestTokenNotFoundException
+	assert.ErrorAs(t, err, &tokenNotFound)
+}
+
+func TestCreateAndUpdateResource(t *testing.T) {
+	t.Parallel()
+
+	cloud := New(testRegion)
+	cloud.AddType(Type{Name: bucketType, PrimaryIdentifier: "BucketName"})
+	client := cloudControl(cloud)
+
+	created, err := client.CreateResource(context.Background(), &cloudcontrol.CreateResourceInput{
+		TypeName:     awsgo.String(bucketType),
+		DesiredState: awsgo.String(`{"BucketName": "bucket", "Tags": [{"Key": "a", "Value": "1"}]}`),
+	})
+	require.NoError(t, err)
+	event := requestStatus(t, client, created.ProgressEvent.RequestToken)
+	assert.Equal(t, types.OperationStatusSuccess, event.OperationStatus)
+	assert.Equal(t, "bucket", awsgo.ToString(event.Identifier))
+
+	generated, err := client.CreateResource(context.Background(), &cloudcontrol.CreateResourceInput{
+		TypeName:     awsgo.String(logGroup),
+		DesiredState: awsgo.String(`{}`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "loggroup-2", awsgo.ToString(generated.ProgressEvent.Identifier))
+
+	updated, err := client.UpdateResource(context.Background(), &cloudcontrol.UpdateResourceInput{
+		TypeName:      awsgo.String(bucketType),
+		Identifier:    awsgo.String("bucket"),
+		PatchDocument: awsgo.String(`[{"op": "replace", "path": "/Tags/0/Value", "value": "2"}, {"op": "add", "path": "/Versioning", "value": true}]`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.OperationStatusSuccess, requestStatus(t, client, updated.ProgressEvent.RequestToken).OperationStatus)
+
+	resource, err := client.GetResource(context.Background(), &cloudcontrol.GetResourceInput{TypeName: awsgo.String(bucketType), Identifier: awsgo.String("bucket")})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"BucketName": "bucket", "Tags": [{"Key": "a", "Value": "2"}], "Versioning": true}`, awsgo.ToString(resource.ResourceDescription.Properties))
+
+	invalid, err := client.UpdateResource(context.Background(), &cloudcontrol.UpdateResourceInput{
+		TypeName:      awsgo.String(bucketType),
+		Identifier:    awsgo.String("bucket"),
+		PatchDocument: awsgo.String(`[{"op": "replace", "path": "/Missing/0", "value": "x"}]`),
+	})
+	require.NoError(t, err)
+	event = requestStatus(t, client, invalid.ProgressEvent.RequestToken)
+	assert.Equal(t, types.OperationStatusFailed, event.OperationStatus)
+	assert.Equal(t, types.HandlerErrorCodeInvalidRequest, event.ErrorCode)
+}
+
+func TestInjectedFaults(t *testing.T) {
+	t.Parallel()
+
+	cloud := New(testRegion)
+	cloud.AddResource(testRegion, Resource{TypeName: logGroup, Identifier: "group"})
+	cloud.Throttle("DeleteResource", 2)
+	cloud.Inject(Fault{Operation: "GetResource", Identifier: "group", Err: &types.ServiceInternalErrorException{Message: awsgo.String("boom")}})
+	client := cloudControl(cloud)
+
+	var throttling *types.ThrottlingException
+	for attempt := 0; attempt < 2; attempt++ {
+		_, err := client.DeleteResource(context.Background(), deleteInput(logGroup, "group", "token"))
+		assert.ErrorAs(t, err, &throttling)
+	}
+	_, err := client.DeleteResource(context.Background(), deleteInput(logGroup, "group", "token"))
+	assert.NoError(t, err)
+
+	_, err = client.GetResource(context.Background(), &cloudcontrol.GetResourceInput{TypeName: awsgo.String(logGroup), Identifier: awsgo.String("group")})
+	var internal *types.ServiceInternalErrorException
+	assert.ErrorAs(t, err, &internal)
+
+	calls := cloud.CallsTo("DeleteResource")
+	require.Len(t, calls, 3)
+	assert.Error(t, calls[0].Err)
+	assert.NoError(t, calls[2].Err)
+	assert.Equal(t, Call{Operation: "DeleteResource", Region: testRegion, TypeName: logGroup, Identifier: "group"}, calls[2])
+}
+
+func TestLatencyRespectsCancellation(t *testing.T) {
+	t.Parallel()
+
+	cloud := New(testRegion)
+	cloud.Latency = 50 * time.Millisecond
+	client := cloudControl(cloud)
+
+	start := time.Now()
+	_, err := client.ListResources(context.Background(), &cloudcontrol.ListResourcesInput{TypeName: awsgo.String(logGroup)})
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(cloud.Latency))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	cloud.Latency = testTimeout
+	_, err = client.ListResources(ctx, &cloudcontrol.ListResourcesInput{TypeName: awsgo.String(logGroup)})
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,72 @@
+package fakecloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+// CloudFormation is a fake CloudFormation client of a region of a Cloud, which lists and describes the resource
+// types of the cloud
+type CloudFormation struct {
+	cloud  *Cloud
+	region string
+}
+
+// ListTypes lists the resource types of the cloud, sorted by name, as public, fully mutable AWS types. The filters of
+// the request are ignored.
+func (client *CloudFormation) ListTypes(ctx context.Context, params *cloudformation.ListTypesInput, optFns ...func(*cloudformation.Options)) (*cloudformation.ListTypesOutput, error) {
+	if err := client.cloud.call(ctx, "ListTypes", client.region, "", ""); err != nil {
+		return nil, err
+	}
+
+	client.cloud.mutex.Lock()
+	defer client.cloud.mutex.Unlock()
+
+	typeNames := []string{}
+	for typeName := range client.cloud.types {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+
+	start, end, nextToken, err := page(len(typeNames), params.NextToken, client.cloud.pageSize(params.MaxResults))
+	if err != nil {
+		return nil, &types.CFNRegistryException{Message: awsgo.String(fmt.Sprintf("Invalid NextToken %s", awsgo.ToString(params.NextToken)))}
+	}
+
+	summaries := []types.TypeSummary{}
+	for _, typeName := range typeNames[start:end] {
+		summaries = append(summaries, types.TypeSummary{
+			Type:     types.RegistryTypeResource,
+			TypeName: awsgo.String(typeName),
+		})
+	}
+	return &cloudformation.ListTypesOutput{TypeSummaries: summaries, NextToken: nextToken}, nil
+}
+
+// DescribeType returns the schema of a resource type of the cloud
+func (client *CloudFormation) DescribeType(ctx context.Context, params *cloudformation.DescribeTypeInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeTypeOutput, error) {
+	typeName := awsgo.ToString(params.TypeName)
+	if err := client.cloud.call(ctx, "DescribeType", client.region, typeName, ""); err != nil {
+		return nil, err
+	}
+
+	client.cloud.mutex.Lock()
+	defer client.cloud.mutex.Unlock()
+
+	t, ok := client.cloud.types[typeName]
+	if !ok {
+		return nil, &types.TypeNotFoundException{Message: awsgo.String(fmt.Sprintf("Type %s was not found", typeName))}
+	}
+	return &cloudformation.DescribeTypeOutput{
+		Type:             types.RegistryTypeResource,
+		TypeName:         awsgo.String(t.Name),
+		Schema:           awsgo.String(t.schema()),
+		ProvisioningType: types.ProvisioningTypeFullyMutable,
+		Visibility:       types.VisibilityPublic,
+	}, nil
+}
@@ -0,0 +1,52 @@
+package fakecloud
+
+import (
+	"context"
+	"testing"
+
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTypesPaginates(t *testing.T) {
+	t.Parallel()
+
+	cloud := New(testRegion)
+	cloud.PageSize = 1
+	cloud.AddType(Type{Name: vpcType})
+	cloud.AddResource(testRegion, Resource{TypeName: logGroup, Identifier: "group"})
+
+	typeNames := []string{}
+	paginator := cloudformation.NewListTypesPaginator(cloud.CloudFormation(awsgo.Config{Region: testRegion}), &cloudformation.ListTypesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.Background())
+		require.NoError(t, err)
+		for _, summary := range output.TypeSummaries {
+			typeNames = append(typeNames, awsgo.ToString(summary.TypeName))
+		}
+	}
+
+	assert.Equal(t, []string{vpcType, logGroup}, typeNames)
+}
+
+func TestDescribeTypeReturnsSchema(t *testing.T) {
+	t.Parallel()
+
+	cloud := New(testRegion)
+	cloud.AddType(Type{Name: bucketType, PrimaryIdentifier: "BucketName"})
+	cloud.AddType(Type{Name: logGroup, Schema: `{"readOnlyProperties": ["/properties/Arn"]}`})
+	client := cloud.CloudFormation(awsgo.Config{Region: testRegion})
+
+	output, err := client.DescribeType(context.Background(), &cloudformation.DescribeTypeInput{TypeName: awsgo.String(bucketType)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"typeName": "AWS::S3::Bucket", "primaryIdentifier": ["/properties/BucketName"]}`, awsgo.ToString(output.Schema))
+
+	output, err = client.DescribeType(context.Background(), &cloudformation.DescribeTypeInput{TypeName: awsgo.String(logGroup)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"readOnlyProperties": ["/properties/Arn"]}`, awsgo.ToString(output.Schema))
+
+	_, err = client.DescribeType(context.Background(), &cloudformation.DescribeTypeInput{TypeName: awsgo.String("AWS::Missing::Type")})
+	assert.Error(t, err)
+}
@@ -0,0 +1,49 @@
+package fakecloud
+
+import (
+	"context"
+	"fmt"
+
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/go-commons/collections"
+)
+
+// EC2 is a fake EC2 client of a region of a Cloud, which describes the regions of the cloud
+type EC2 struct {
+	cloud  *Cloud
+	region string
+}
+
+// DescribeRegions returns the regions of the cloud that are enabled, or all of them with AllRegions, in the order
+// they were added. Regions can be selected by name with RegionNames, but the other filters are ignored.
+func (client *EC2) DescribeRegions(ctx context.Context, params *ec2.DescribeRegionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeRegionsOutput, error) {
+	if err := client.cloud.call(ctx, "DescribeRegions", client.region, "", ""); err != nil {
+		return nil, err
+	}
+
+	client.cloud.mutex.Lock()
+	defer client.cloud.mutex.Unlock()
+
+	regions := []types.Region{}
+	for _, region := range client.cloud.regions {
+		if !region.enabled() && !awsgo.ToBool(params.AllRegions) {
+			continue
+		}
+		if len(params.RegionNames) > 0 && !collections.ListContainsElement(params.RegionNames, region.Name) {
+			continue
+		}
+		dnsSuffix := "amazonaws.com"
+		if partition, err := aws.PartitionOfRegion(region.Name); err == nil {
+			dnsSuffix = partition.DNSSuffix
+		}
+		regions = append(regions, types.Region{
+			RegionName:  awsgo.String(region.Name),
+			Endpoint:    awsgo.String(fmt.Sprintf("ec2.%s.%s", region.Name, dnsSuffix)),
+			OptInStatus: awsgo.String(region.OptInStatus),
+		})
+	}
+	return &ec2.DescribeRegionsOutput{Regions: regions}, nil
+}
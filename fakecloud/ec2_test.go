@@ -0,0 +1,34 @@
+package fakecloud
+
+import (
+	"context"
+	"testing"
+
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribeRegions(t *testing.T) {
+	t.Parallel()
+
+	cloud := New("us-east-1", "eu-west-1")
+	cloud.AddRegion(Region{Name: "af-south-1", OptInStatus: OptedIn})
+	cloud.AddRegion(Region{Name: "ap-east-1", OptInStatus: NotOptedIn})
+	client := cloud.EC2(awsgo.Config{Region: "us-east-1"})
+
+	regionNames := func(input *ec2.DescribeRegionsInput) []string {
+		output, err := client.DescribeRegions(context.Background(), input)
+		require.NoError(t, err)
+		names := []string{}
+		for _, region := range output.Regions {
+			names = append(names, awsgo.ToString(region.RegionName))
+		}
+		return names
+	}
+
+	assert.Equal(t, []string{"us-east-1", "eu-west-1", "af-south-1"}, regionNames(&ec2.DescribeRegionsInput{}))
+	assert.Equal(t, []string{"us-east-1", "eu-west-1", "af-south-1", "ap-east-1"}, regionNames(&ec2.DescribeRegionsInput{AllRegions: awsgo.Bool(true)}))
+	assert.Equal(t, []string{"eu-west-1"}, regionNames(&ec2.DescribeRegionsInput{RegionNames: []string{"eu-west-1", "ap-east-1"}}))
+}
@@ -0,0 +1,66 @@
+package fakecloud
+
+import (
+	"fmt"
+
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+)
+
+// Fault makes the calls that it matches fail with Err. Empty fields match any call.
+type Fault struct {
+	// Operation is the name of the API operation, such as "DeleteResource"
+	Operation  string
+	Region     string
+	TypeName   string
+	Identifier string
+	Err        error
+	// Times is the number of calls that fail before the fault stops matching. With 0, every matching call fails.
+	Times int
+
+	triggered int
+}
+
+func (fault *Fault) matches(operation, region, typeName, identifier string) bool {
+	if fault.Times > 0 && fault.triggered >= fault.Times {
+		return false
+	}
+	return (fault.Operation == "" || fault.Operation == operation) &&
+		(fault.Region == "" || fault.Region == region) &&
+		(fault.TypeName == "" || fault.TypeName == typeName) &&
+		(fault.Identifier == "" || fault.Identifier == identifier)
+}
+
+func (fault *Fault) trigger() error {
+	fault.triggered++
+	return fault.Err
+}
+
+// Inject makes the calls matched by fault fail, until it has been triggered fault.Times times. Faults are matched in
+// the order they were injected.
+func (cloud *Cloud) Inject(fault Fault) {
+	cloud.mutex.Lock()
+	defer cloud.mutex.Unlock()
+	cloud.faults = append(cloud.faults, &fault)
+}
+
+// Throttle makes the next times calls to operation fail with a ThrottlingException, as they do when the rate limit
+// of the account is exceeded
+func (cloud *Cloud) Throttle(operation string, times int) {
+	cloud.Inject(Fault{
+		Operation: operation,
+		Err:       &types.ThrottlingException{Message: awsgo.String(fmt.Sprintf("Rate exceeded for %s", operation))},
+		Times:     times,
+	})
+}
+
+// FailDeletion makes the deletion of a resource fail with the given handler error code once it is submitted, as
+// Cloud Control reports the failures of resource handlers
+func (cloud *Cloud) FailDeletion(region, typeName, identifier string, code types.HandlerErrorCode) {
+	cloud.mutex.Lock()
+	defer cloud.mutex.Unlock()
+
+	if resource := cloud.find(region, typeName, identifier); resource != nil {
+		resource.DeleteFailure = code
+	}
+}
@@ -0,0 +1,231 @@
+package fakecloud
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// These tests run the scans and nukes of the aws package against the fake cloud
+
+// plannedRun returns a run journal of the nuke of account, so that the outcome of each deletion can be checked
+func plannedRun(t *testing.T, account *aws.AwsAccountResources) *aws.Run {
+	run := aws.NewRun(filepath.Join(t.TempDir(), "run.json"))
+	require.NoError(t, run.Plan(account))
+	return run
+}
+
+func singleRegionAccount(resources ...*aws.AwsResource) *aws.AwsAccountResources {
+	return &aws.AwsAccountResources{
+		Resources: map[string]aws.AwsRegionResource{testRegion: {Resources: resources}},
+	}
+}
+
+func entryStates(run *aws.Run) map[string]aws.RunEntryState {
+	states := map[string]aws.RunEntryState{}
+	for _, entry := range run.Entries {
+		states[entry.Identifier] = entry.State
+	}
+	return states
+}
+
+func TestScanFakeCloud(t *testing.T) {
+	t.Parallel()
+
+	cloud := New("us-east-1", "eu-west-1")
+	cloud.AddRegion(Region{Name: "ap-east-1", OptInStatus: NotOptedIn})
+	cloud.PageSize = 2
+	for _, name := range []string{"a", "b", "c"} {
+		cloud.AddResource("us-east-1", Resource{TypeName: logGroup, Identifier: name})
+	}
+	cloud.AddResource("eu-west-1", Resource{TypeName: bucketType, Identifier: "bucket"})
+	ctx := aws.WithClientFactory(context.Background(), cloud)
+
+	regions, err := aws.GetEnabledRegionsWithContext(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"us-east-1", "eu-west-1"}, regions)
+
+	resourceTypes := aws.ListResourceTypesWithContext(ctx)
+	assert.Contains(t, resourceTypes, logGroup)
+	assert.Contains(t, resourceTypes, bucketType)
+
+	account, err := aws.GetAllResources(ctx, regions, time.Now(), []string{logGroup, bucketType}, config.Config{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, account.Resources["us-east-1"].Resources[0].Identifiers)
+	assert.Empty(t, account.Resources["us-east-1"].Resources[1].Identifiers)
+	assert.Equal(t, []string{"bucket"}, account.Resources["eu-west-1"].Resources[1].Identifiers)
+	assert.Len(t, cloud.CallsTo("ListResources"), 2*2+1)
+}
+
+func TestNukeRetriesThrottledDeletions(t *testing.T) {
+	t.Parallel()
+
+	cloud := New(testRegion)
+	cloud.AddResource(testRegion, Resource{TypeName: logGroup, Identifier: "group"})
+	cloud.Throttle("DeleteResource", 1)
+	ctx := aws.WithClientFactory(context.Background(), cloud)
+
+	account := singleRegionAccount(&aws.AwsResource{TypeName: logGroup, Identifiers: []string{"group"}})
+	run := plannedRun(t, account)
+	require.NoError(t, aws.NukeAllResources(ctx, account, []string{testRegion}, aws.NukeOptions{Run: run}))
+
+	assert.False(t, cloud.Exists(testRegion, logGroup, "group"))
+	assert.Len(t, cloud.CallsTo("DeleteResource"), 2)
+	assert.Equal(t, map[string]aws.RunEntryState{"group": aws.RunEntrySucceeded}, entryStates(run))
+}
+
+func TestNukeFollowsTheOrderOfTheResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		typeOrder []string
+		expected  map[string]aws.RunEntryState
+		remaining []Resource
+	}{
+		{
+			name:      "dependents first",
+			typeOrder: []string{subnetType, vpcType},
+			expected:  map[string]aws.RunEntryState{"subnet-1": aws.RunEntrySucceeded, "vpc-1": aws.RunEntrySucceeded},
+			remaining: []Resource{},
+		},
+		{
+			name:      "dependencies first",
+			typeOrder: []string{vpcType, subnetType},
+			expected:  map[string]aws.RunEntryState{"subnet-1": aws.RunEntrySucceeded, "vpc-1": aws.RunEntryFailed},
+			remaining: []Resource{{TypeName: vpcType, Identifier: "vpc-1"}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			cloud := New(testRegion)
+			cloud.AddResource(testRegion, Resource{TypeName: vpcType, Identifier: "vpc-1"})
+			cloud.AddResource(testRegion, Resource{TypeName: subnetType, Identifier: "subnet-1", DependsOn: []string{"vpc-1"}})
+			ctx := aws.WithClientFactory(context.Background(), cloud)
+
+			identifiers := map[string]string{vpcType: "vpc-1", subnetType: "subnet-1"}
+			resources := []*aws.AwsResource{}
+			for _, typeName := range testCase.typeOrder {
+				resources = append(resources, &aws.AwsResource{TypeName: typeName, Identifiers: []string{identifiers[typeName]}})
+			}
+			account := singleRegionAccount(resources...)
+			run := plannedRun(t, account)
+
+			require.NoError(t, aws.NukeAllResources(ctx, account, []string{testRegion}, aws.NukeOptions{Run: run}))
+			assert.Equal(t, testCase.expected, entryStates(run))
+			assert.Equal(t, testCase.remaining, cloud.Resources(testRegion))
+		})
+	}
+}
+
+func TestNukeReportsFailedDeletions(t *testing.T) {
+	t.Parallel()
+
+	cloud := New(testRegion)
+	cloud.AddResource(testRegion, Resource{TypeName: logGroup, Identifier: "kept"})
+	cloud.AddResource(testRegion, Resource{TypeName: logGroup, Identifier: "deleted"})
+	cloud.FailDeletion(testRegion, logGroup, "kept", types.HandlerErrorCodeAccessDenied)
+	ctx := aws.WithClientFactory(context.Background(), cloud)
+
+	account := singleRegionAccount(&aws.AwsResource{TypeName: logGroup, Identifiers: []string{"kept", "deleted"}})
+	run := plannedRun(t, account)
+	require.NoError(t, aws.NukeAllResources(ctx, account, []string{testRegion}, aws.NukeOptions{Run: run}))
+
+	failed := run.FailedEntries()
+	require.Len(t, failed, 1)
+	assert.Equal(t, "kept", failed[0].Identifier)
+	assert.Equal(t, string(types.HandlerErrorCodeAccessDenied), failed[0].ErrorCode)
+	assert.True(t, cloud.Exists(testRegion, logGroup, "kept"))
+	assert.False(t, cloud.Exists(testRegion, logGroup, "deleted"))
+}
+
+func TestAsyncNukeCompletesOnRefresh(t *testing.T) {
+	t.Parallel()
+
+	cloud := New(testRegion)
+	cloud.PollsToComplete = 1
+	cloud.AddResource(testRegion, Resource{TypeName: logGroup, Identifier: "group"})
+	ctx := aws.WithClientFactory(context.Background(), cloud)
+
+	account := singleRegionAccount(&aws.AwsResource{TypeName: logGroup, Identifiers: []string{"group"}})
+	run := plannedRun(t, account)
+	require.NoError(t, aws.NukeAllResources(ctx, account, []string{testRegion}, aws.NukeOptions{Run: run, Async: true}))
+	assert.Equal(t, map[string]aws.RunEntryState{"group": aws.RunEntrySubmitted}, entryStates(run))
+
+	require.NoError(t, aws.RefreshRunStatus(ctx, run, false, time.Minute))
+	assert.Equal(t, map[string]aws.RunEntryState{"group": aws.RunEntrySubmitted}, entryStates(run))
+	assert.True(t, cloud.Exists(testRegion, logGroup, "group"))
+
+	require.NoError(t, aws.RefreshRunStatus(ctx, run, false, time.Minute))
+	assert.Equal(t, map[string]aws.RunEntryState{"group": aws.RunEntrySucceeded}, entryStates(run))
+	assert.False(t, cloud.Exists(testRegion, logGroup, "group"))
+}
+
+func TestNukeCustomResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	expired := []map[string]interface{}{
+		{"Key": aws.SnapshotRunIDTagKey, "Value": "run-1"},
+		{"Key": aws.SnapshotExpiresAtTagKey, "Value": time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)},
+	}
+	cloud := New(testRegion)
+	cloud.PageSize = 1
+	cloud.AddResource(testRegion, Resource{TypeName: ebsSnapshotType, Identifier: "snap-1"})
+	cloud.AddResource(testRegion, Resource{TypeName: ebsSnapshotType, Identifier: "snap-final", Properties: map[string]interface{}{"Tags": expired}})
+	cloud.AddResource(testRegion, Resource{TypeName: amiType, Identifier: "ami-1"})
+	cloud.AddResource(testRegion, Resource{TypeName: amiType, Identifier: "ami-locked", DeleteFailure: types.HandlerErrorCodeAccessDenied})
+	cloud.AddResource(testRegion, Resource{TypeName: defaultVPCType, Identifier: "vpc-default"})
+	ctx := aws.WithClientFactory(context.Background(), cloud)
+
+	resourceTypes := []string{ebsSnapshotType, amiType, defaultVPCType, aws.ExpiredFinalSnapshots{}.TypeName()}
+	account, err := aws.GetAllResources(ctx, []string{testRegion}, time.Now(), resourceTypes, config.Config{})
+	require.NoError(t, err)
+	identifiers := map[string][]string{}
+	for _, resource := range account.Resources[testRegion].Resources {
+		identifiers[resource.TypeName] = resource.Identifiers
+	}
+	assert.Equal(t, map[string][]string{
+		ebsSnapshotType:                        {"snap-1"},
+		amiType:                                {"ami-1", "ami-locked"},
+		defaultVPCType:                         {"vpc-default"},
+		aws.ExpiredFinalSnapshots{}.TypeName(): {"ebs-snapshot/snap-final"},
+	}, identifiers)
+
+	run := plannedRun(t, account)
+	err = aws.NukeAllResources(ctx, account, []string{testRegion}, aws.NukeOptions{Run: run})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "AccessDenied")
+
+	assert.Equal(t, []Resource{{TypeName: amiType, Identifier: "ami-locked", DeleteFailure: types.HandlerErrorCodeAccessDenied}}, cloud.Resources(testRegion))
+	failed := run.FailedEntries()
+	require.Len(t, failed, 1)
+	assert.Equal(t, "ami-locked", failed[0].Identifier)
+	assert.Len(t, cloud.CallsTo("DescribeImages"), 1)
+}
+
+func TestAccountIdentityOfFakeCloud(t *testing.T) {
+	t.Parallel()
+
+	cloud := New(testRegion)
+	ctx := aws.WithClientFactory(context.Background(), cloud)
+	identity, err := aws.GetAccountIdentity(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &aws.AccountIdentity{AccountID: DefaultAccountID, CallerArn: "arn:aws:iam::123456789012:user/fakecloud"}, identity)
+
+	cloud.Account = aws.AccountIdentity{AccountID: "111111111111", Alias: "sandbox", CallerArn: "arn:aws-cn:iam::111111111111:role/nuke"}
+	identity, err = aws.GetAccountIdentity(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &cloud.Account, identity)
+	assert.Len(t, cloud.CallsTo("GetCallerIdentity"), 2)
+}
@@ -0,0 +1,174 @@
+package scenario
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/commands"
+	"github.com/gruntwork-io/cloud-nuke/fakecloud"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// Result is the outcome of running a scenario
+type Result struct {
+	// Cloud is the fake cloud after the run
+	Cloud *fakecloud.Cloud
+	// Journal is the run journal of the nuke, or nil if nothing was nuked
+	Journal *aws.Run
+	// Err is the error that `cloud-nuke aws` would have failed with
+	Err error
+	// ExitCode is the exit code that `cloud-nuke aws` would have exited with
+	ExitCode int
+}
+
+// Execute runs `cloud-nuke aws --force` with the run options of the scenario against a new fake cloud of the
+// account, in the partition of the account.
+func (scenario *Scenario) Execute(ctx context.Context) (*Result, error) {
+	journalDir, err := ioutil.TempDir("", "cloud-nuke-scenario-")
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	defer os.RemoveAll(journalDir)
+
+	partition, err := scenario.partition()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{Cloud: scenario.NewCloud()}
+	ctx = aws.WithPartition(aws.WithClientFactory(ctx, result.Cloud), partition)
+
+	journalPath := filepath.Join(journalDir, "run.json")
+	result.Err = commands.RunAws(commands.WithoutCountdown(ctx), scenario.args(journalPath))
+	result.ExitCode = exitCode(result.Err)
+
+	// The journal is only written once there is something to nuke
+	if _, err := os.Stat(journalPath); err == nil {
+		result.Journal, err = aws.LoadRun(journalPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// args returns the flags of `cloud-nuke aws` that the run options of the scenario stand for
+func (scenario *Scenario) args(journalPath string) []string {
+	options := scenario.Run
+	args := []string{"--force", "--run-file", journalPath}
+
+	repeated := []struct {
+		flag   string
+		values []string
+	}{
+		{"allow-account", options.AllowAccounts},
+		{"deny-account", options.DenyAccounts},
+		{"region", options.Regions},
+		{"exclude-region", options.ExcludeRegions},
+		{"resource-type", options.ResourceTypes},
+		{"exclude-resource-type", options.ExcludeResourceTypes},
+	}
+	for _, option := range repeated {
+		for _, value := range option.values {
+			args = append(args, "--"+option.flag, value)
+		}
+	}
+
+	if options.Config != "" {
+		args = append(args, "--config", scenario.path(options.Config))
+	}
+	if options.OlderThan != "" {
+		args = append(args, "--older-than", options.OlderThan)
+	}
+	if options.DryRun {
+		args = append(args, "--dry-run")
+	}
+	if options.SkipPrepare {
+		args = append(args, "--skip-prepare")
+	}
+	if options.Verify {
+		args = append(args, "--verify")
+	}
+	return append(args, options.Flags...)
+}
+
+// path resolves a path of the scenario file against its directory
+func (scenario *Scenario) path(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(scenario.dir, path)
+}
+
+// exitCode returns the exit code that the entrypoint of cloud-nuke exits with after err
+func exitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	if errorWithExitCode, ok := errors.Unwrap(err).(errors.ErrorWithExitCode); ok {
+		return errorWithExitCode.ExitCode
+	}
+	return 1
+}
+
+// Check compares the result of the run with the expectations of the scenario, returning a description of each one
+// that wasn't met
+func (scenario *Scenario) Check(result *Result) []string {
+	failures := []string{}
+	expect := scenario.Expect
+
+	if result.ExitCode != expect.ExitCode {
+		failures = append(failures, fmt.Sprintf("exit code is %d, expected %d (error: %v)", result.ExitCode, expect.ExitCode, result.Err))
+	}
+	if expect.Error != "" && (result.Err == nil || !strings.Contains(result.Err.Error(), expect.Error)) {
+		failures = append(failures, fmt.Sprintf("error is %v, expected an error containing %q", result.Err, expect.Error))
+	}
+
+	expect.Deleted.each(func(region, typeName, identifier string) {
+		if result.Cloud.Exists(region, typeName, identifier) {
+			failures = append(failures, fmt.Sprintf("%s %s in %s was not deleted", typeName, identifier, region))
+		}
+	})
+	expect.Kept.each(func(region, typeName, identifier string) {
+		if !result.Cloud.Exists(region, typeName, identifier) {
+			failures = append(failures, fmt.Sprintf("%s %s in %s was deleted, but should have been kept", typeName, identifier, region))
+		}
+	})
+	expect.Failed.each(func(region, typeName, identifier string) {
+		if !journalEntryFailed(result.Journal, region, typeName, identifier) {
+			failures = append(failures, fmt.Sprintf("the deletion of %s %s in %s did not fail", typeName, identifier, region))
+		}
+	})
+	return failures
+}
+
+// journalEntryFailed returns true if the journal records the deletion of the resource as failed
+func journalEntryFailed(run *aws.Run, region, typeName, identifier string) bool {
+	if run == nil {
+		return false
+	}
+	for _, entry := range run.FailedEntries() {
+		if entry.Region == region && entry.TypeName == typeName && entry.Identifier == identifier {
+			return true
+		}
+	}
+	return false
+}
+
+// RunFile loads, runs and checks the scenario of a file, returning the expectations that weren't met
+func RunFile(ctx context.Context, path string) ([]string, error) {
+	scenario, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+	result, err := scenario.Execute(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return scenario.Check(result), nil
+}
@@ -0,0 +1,376 @@
+// Package scenario runs declarative integration tests of cloud-nuke against the fake cloud. A scenario, written in
+// YAML, describes a test account (its regions, resources and injected failures), the run made against it (the config
+// file and the rules that select what is nuked) and the expected outcome (what is deleted, what is kept, and the exit
+// code), so that changes to nuke rules can be regression tested without AWS.
+package scenario
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/fakecloud"
+	"github.com/gruntwork-io/go-commons/collections"
+	"github.com/gruntwork-io/go-commons/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// defaultAccountID is the account of scenarios that don't set one
+const defaultAccountID = "123456789012"
+
+// Scenario is a test account, the run made against it, and the expected outcome of the run
+type Scenario struct {
+	Name    string   `yaml:"name"`
+	Account Account  `yaml:"account"`
+	Regions []Region `yaml:"regions"`
+	// Types are resource types that exist without resources, so that they can be selected
+	Types []Type `yaml:"types"`
+	// Resources are the resources of the account, by region then type
+	Resources map[string]map[string][]Resource `yaml:"resources"`
+	Faults    []Fault                          `yaml:"faults"`
+	Cloud     CloudOptions                     `yaml:"cloud"`
+	Run       RunOptions                       `yaml:"run"`
+	Expect    Expectations                     `yaml:"expect"`
+
+	// dir is the directory of the scenario file, which the paths of the scenario are relative to
+	dir string
+}
+
+// Account is the identity of the account that the run checks the account rules against
+type Account struct {
+	ID        string `yaml:"id"`
+	Alias     string `yaml:"alias"`
+	CallerArn string `yaml:"caller_arn"`
+	// Partition is the partition of the account, such as aws-cn. Defaults to the partition of CallerArn, or else aws.
+	Partition string `yaml:"partition"`
+}
+
+// Region is a region of the account. Regions that are only named in resources are enabled.
+type Region struct {
+	Name string `yaml:"name"`
+	// OptInStatus is opt-in-not-required, opted-in or not-opted-in
+	OptInStatus string `yaml:"opt_in_status"`
+}
+
+// Type is a resource type of the account
+type Type struct {
+	Name              string `yaml:"name"`
+	PrimaryIdentifier string `yaml:"primary_identifier"`
+}
+
+// Resource is a resource of the account
+type Resource struct {
+	Identifier string                 `yaml:"identifier"`
+	Properties map[string]interface{} `yaml:"properties"`
+	// Tags are added to the properties as a Tags list of Key and Value pairs
+	Tags      map[string]string `yaml:"tags"`
+	DependsOn []string          `yaml:"depends_on"`
+	// DeleteFailure is the handler error code, such as AccessDenied, that the deletion of the resource fails with
+	DeleteFailure string `yaml:"delete_failure"`
+}
+
+// Fault makes the calls that it matches fail. Empty fields match any call.
+type Fault struct {
+	Operation  string `yaml:"operation"`
+	Region     string `yaml:"region"`
+	Type       string `yaml:"type"`
+	Identifier string `yaml:"identifier"`
+	// Error is the Cloud Control error returned, one of the keys of faultErrors
+	Error   string `yaml:"error"`
+	Message string `yaml:"message"`
+	// Times is the number of calls that fail. With 0, every matching call fails.
+	Times int `yaml:"times"`
+}
+
+// CloudOptions tune the fake cloud
+type CloudOptions struct {
+	PollsToComplete int `yaml:"polls_to_complete"`
+	PageSize        int `yaml:"page_size"`
+}
+
+// RunOptions are the options of the run, named after the flags of `cloud-nuke aws`
+type RunOptions struct {
+	// Config is the path of the config file, relative to the scenario file
+	Config               string   `yaml:"config"`
+	AllowAccounts        []string `yaml:"allow_accounts"`
+	DenyAccounts         []string `yaml:"deny_accounts"`
+	Regions              []string `yaml:"regions"`
+	ExcludeRegions       []string `yaml:"exclude_regions"`
+	ResourceTypes        []string `yaml:"resource_types"`
+	ExcludeResourceTypes []string `yaml:"exclude_resource_types"`
+	OlderThan            string   `yaml:"older_than"`
+	DryRun               bool     `yaml:"dry_run"`
+	// SkipPrepare must be set to nuke types with preparation steps, such as emptying buckets, as those call the
+	// service APIs that the fake cloud doesn't simulate
+	SkipPrepare bool `yaml:"skip_prepare"`
+	Verify      bool `yaml:"verify"`
+	// Flags are other flags of `cloud-nuke aws`, such as --quarantine=72h, which are passed as they are
+	Flags []string `yaml:"flags"`
+}
+
+// Expectations are the expected outcome of the run
+type Expectations struct {
+	// Deleted are the resources that must be gone after the run
+	Deleted ResourceSet `yaml:"deleted"`
+	// Kept are the resources that must remain after the run, such as those protected by the rules
+	Kept ResourceSet `yaml:"kept"`
+	// Failed are the resources whose deletion must have been attempted and failed
+	Failed   ResourceSet `yaml:"failed"`
+	ExitCode int         `yaml:"exit_code"`
+	// Error is text that the error of the run must contain
+	Error string `yaml:"error"`
+}
+
+// ResourceSet lists resource identifiers by region then type
+type ResourceSet map[string]map[string][]string
+
+// each calls fn for every resource of the set, sorted by region, type and identifier
+func (set ResourceSet) each(fn func(region, typeName, identifier string)) {
+	regions := []string{}
+	for region := range set {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	for _, region := range regions {
+		typeNames := []string{}
+		for typeName := range set[region] {
+			typeNames = append(typeNames, typeName)
+		}
+		sort.Strings(typeNames)
+
+		for _, typeName := range typeNames {
+			identifiers := append([]string{}, set[region][typeName]...)
+			sort.Strings(identifiers)
+			for _, identifier := range identifiers {
+				fn(region, typeName, identifier)
+			}
+		}
+	}
+}
+
+// faultErrors create the errors that faults can inject, by name
+var faultErrors = map[string]func(message *string) error{
+	"Throttling":             func(message *string) error { return &types.ThrottlingException{Message: message} },
+	"ServiceInternalError":   func(message *string) error { return &types.ServiceInternalErrorException{Message: message} },
+	"NetworkFailure":         func(message *string) error { return &types.NetworkFailureException{Message: message} },
+	"HandlerInternalFailure": func(message *string) error { return &types.HandlerInternalFailureException{Message: message} },
+	"ConcurrentOperation":    func(message *string) error { return &types.ConcurrentOperationException{Message: message} },
+	"ResourceConflict":       func(message *string) error { return &types.ResourceConflictException{Message: message} },
+	"ResourceNotFound":       func(message *string) error { return &types.ResourceNotFoundException{Message: message} },
+	"InvalidCredentials":     func(message *string) error { return &types.InvalidCredentialsException{Message: message} },
+	"InvalidRequest":         func(message *string) error { return &types.InvalidRequestException{Message: message} },
+}
+
+// Load reads a scenario file. Scenarios without a name are named after their file.
+func Load(path string) (*Scenario, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	scenario := &Scenario{}
+	if err := yaml.UnmarshalStrict(contents, scenario); err != nil {
+		return nil, errors.WithStackTrace(InvalidScenarioError{Path: path, Reason: err.Error()})
+	}
+	if scenario.Name == "" {
+		scenario.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	scenario.dir = filepath.Dir(path)
+
+	if err := scenario.validate(); err != nil {
+		return nil, errors.WithStackTrace(InvalidScenarioError{Path: path, Reason: err.Error()})
+	}
+	return scenario, nil
+}
+
+// validate checks the parts of the scenario that YAML decoding can't
+func (scenario *Scenario) validate() error {
+	if _, err := scenario.partition(); err != nil {
+		return err
+	}
+	for _, fault := range scenario.Faults {
+		if _, ok := faultErrors[fault.Error]; !ok {
+			return fmt.Errorf("unknown fault error %q: must be one of %s", fault.Error, strings.Join(faultErrorNames(), ", "))
+		}
+	}
+	for region, resourcesByType := range scenario.Resources {
+		for typeName, resources := range resourcesByType {
+			if strings.HasPrefix(typeName, aws.CustomResourceTypePrefix) && !collections.ListContainsElement(fakecloud.SimulatedCustomTypes, typeName) {
+				return fmt.Errorf("resource type %s can't be simulated: the CloudNuke:: types of the fake cloud are %s", typeName, strings.Join(fakecloud.SimulatedCustomTypes, ", "))
+			}
+			for _, resource := range resources {
+				if resource.Identifier == "" {
+					return fmt.Errorf("a resource of type %s in %s has no identifier", typeName, region)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func faultErrorNames() []string {
+	names := []string{}
+	for name := range faultErrors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// partition returns the partition of the account of the scenario
+func (scenario *Scenario) partition() (aws.Partition, error) {
+	if scenario.Account.Partition != "" {
+		return aws.PartitionByID(scenario.Account.Partition)
+	}
+	if scenario.Account.CallerArn != "" {
+		return aws.PartitionOfArn(scenario.Account.CallerArn)
+	}
+	return aws.PartitionByID("aws")
+}
+
+// identity returns the identity of the account of the scenario
+func (scenario *Scenario) identity() *aws.AccountIdentity {
+	accountID := scenario.Account.ID
+	if accountID == "" {
+		accountID = defaultAccountID
+	}
+	callerArn := scenario.Account.CallerArn
+	if callerArn == "" {
+		// Load and Execute check the partition
+		partition, _ := scenario.partition()
+		callerArn = partition.Arn("iam", "", accountID, "user/cloud-nuke")
+	}
+	return &aws.AccountIdentity{AccountID: accountID, Alias: scenario.Account.Alias, CallerArn: callerArn}
+}
+
+// NewCloud returns a fake cloud holding the account of the scenario
+func (scenario *Scenario) NewCloud() *fakecloud.Cloud {
+	cloud := fakecloud.New()
+	cloud.Account = *scenario.identity()
+	cloud.PollsToComplete = scenario.Cloud.PollsToComplete
+	if scenario.Cloud.PageSize > 0 {
+		cloud.PageSize = scenario.Cloud.PageSize
+	}
+
+	for _, region := range scenario.Regions {
+		cloud.AddRegion(fakecloud.Region{Name: region.Name, OptInStatus: region.OptInStatus})
+	}
+	for _, t := range scenario.Types {
+		cloud.AddType(fakecloud.Type{Name: t.Name, PrimaryIdentifier: t.PrimaryIdentifier})
+	}
+
+	regions := []string{}
+	for region := range scenario.Resources {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	for _, region := range regions {
+		if !scenario.declaresRegion(region) {
+			cloud.AddRegion(fakecloud.Region{Name: region})
+		}
+
+		resourcesByType := scenario.Resources[region]
+		typeNames := []string{}
+		for typeName := range resourcesByType {
+			typeNames = append(typeNames, typeName)
+		}
+		sort.Strings(typeNames)
+
+		for _, typeName := range typeNames {
+			for _, resource := range resourcesByType[typeName] {
+				cloud.AddResource(region, fakecloud.Resource{
+					TypeName:      typeName,
+					Identifier:    resource.Identifier,
+					Properties:    resource.properties(),
+					DependsOn:     resource.DependsOn,
+					DeleteFailure: types.HandlerErrorCode(resource.DeleteFailure),
+				})
+			}
+		}
+	}
+
+	for _, fault := range scenario.Faults {
+		message := fault.Message
+		if message == "" {
+			message = fmt.Sprintf("Injected %s", fault.Error)
+		}
+		cloud.Inject(fakecloud.Fault{
+			Operation:  fault.Operation,
+			Region:     fault.Region,
+			TypeName:   fault.Type,
+			Identifier: fault.Identifier,
+			Err:        faultErrors[fault.Error](awsgo.String(message)),
+			Times:      fault.Times,
+		})
+	}
+	return cloud
+}
+
+func (scenario *Scenario) declaresRegion(name string) bool {
+	for _, region := range scenario.Regions {
+		if region.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// properties returns the properties of the resource, with its tags, as JSON compatible values
+func (resource Resource) properties() map[string]interface{} {
+	properties := map[string]interface{}{}
+	for key, value := range resource.Properties {
+		properties[key] = jsonValue(value)
+	}
+
+	if len(resource.Tags) > 0 {
+		keys := []string{}
+		for key := range resource.Tags {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		tags := []interface{}{}
+		for _, key := range keys {
+			tags = append(tags, map[string]interface{}{"Key": key, "Value": resource.Tags[key]})
+		}
+		properties["Tags"] = tags
+	}
+	return properties
+}
+
+// jsonValue converts the maps that YAML decodes, whose keys can be of any type, to maps with string keys that can be
+// encoded as JSON
+func jsonValue(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[interface{}]interface{}:
+		converted := map[string]interface{}{}
+		for key, item := range typed {
+			converted[fmt.Sprint(key)] = jsonValue(item)
+		}
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, len(typed))
+		for i, item := range typed {
+			converted[i] = jsonValue(item)
+		}
+		return converted
+	}
+	return value
+}
+
+// InvalidScenarioError is returned for scenario files that can't be run
+type InvalidScenarioError struct {
+	Path   string
+	Reason string
+}
+
+func (err InvalidScenarioError) Error() string {
+	return fmt.Sprintf("invalid scenario %s: %s", err.Path, err.Reason)
+}
@@ -0,0 +1,131 @@
+package scenario
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestScenarios runs every scenario of the mocks directory. Add a scenario file there to regression test a change to
+// the nuke rules.
+func TestScenarios(t *testing.T) {
+	t.Parallel()
+
+	paths, err := filepath.Glob("./mocks/*.yaml")
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+
+	for _, path := range paths {
+		path := path
+		t.Run(strings.TrimSuffix(filepath.Base(path), ".yaml"), func(t *testing.T) {
+			t.Parallel()
+
+			failures, err := RunFile(context.Background(), path)
+			require.NoError(t, err)
+			assert.Empty(t, failures)
+		})
+	}
+}
+
+func TestScenarioRunsInThePartitionOfTheAccount(t *testing.T) {
+	t.Parallel()
+
+	scenario, err := Load("./mocks/china.yaml")
+	require.NoError(t, err)
+	result, err := scenario.Execute(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, scenario.Check(result))
+
+	for _, call := range result.Cloud.Calls() {
+		assert.True(t, strings.HasPrefix(call.Region, "cn-"), "%s was called in %s", call.Operation, call.Region)
+	}
+}
+
+func TestScenarioRunsTheFlagsOfTheCommand(t *testing.T) {
+	t.Parallel()
+
+	scenario, err := Load("./mocks/quarantine.yaml")
+	require.NoError(t, err)
+	result, err := scenario.Execute(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, scenario.Check(result))
+
+	// --quarantine goes through the quarantine of `cloud-nuke aws`, which tags the log group instead of deleting it
+	resources := result.Cloud.Resources("us-east-1")
+	require.Len(t, resources, 1)
+	assert.Contains(t, fmt.Sprint(resources[0].Properties["Tags"]), aws.QuarantineTagKey)
+	assert.Nil(t, result.Journal)
+}
+
+func TestLoadRejectsInvalidScenarios(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		contents string
+		reason   string
+	}{
+		{
+			name:     "unknown field",
+			contents: "expect:\n  removed: {}\n",
+			reason:   "field removed not found",
+		},
+		{
+			name:     "unknown fault error",
+			contents: "faults:\n  - operation: DeleteResource\n    error: Boom\n",
+			reason:   `unknown fault error "Boom"`,
+		},
+		{
+			name:     "unknown partition",
+			contents: "account:\n  partition: aws-mars\n",
+			reason:   "aws-mars is not in a known AWS partition",
+		},
+		{
+			name:     "custom resource type",
+			contents: "resources:\n  us-east-1:\n    CloudNuke::S3::Objects:\n      - identifier: bucket\n",
+			reason:   "resource type CloudNuke::S3::Objects can't be simulated",
+		},
+		{
+			name:     "missing identifier",
+			contents: "resources:\n  us-east-1:\n    AWS::Logs::LogGroup:\n      - tags: {team: security}\n",
+			reason:   "has no identifier",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "scenario.yaml")
+			require.NoError(t, ioutil.WriteFile(path, []byte(testCase.contents), 0644))
+
+			_, err := Load(path)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), testCase.reason)
+		})
+	}
+}
+
+func TestCheckReportsUnmetExpectations(t *testing.T) {
+	t.Parallel()
+
+	scenario, err := Load("./mocks/failures.yaml")
+	require.NoError(t, err)
+	scenario.Expect.Deleted["us-east-1"]["AWS::Logs::LogGroup"] = append(scenario.Expect.Deleted["us-east-1"]["AWS::Logs::LogGroup"], "locked")
+	scenario.Expect.ExitCode = 1
+
+	result, err := scenario.Execute(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"exit code is 0, expected 1 (error: <nil>)",
+		"AWS::Logs::LogGroup locked in us-east-1 was not deleted",
+	}, scenario.Check(result))
+}
@@ -0,0 +1,361 @@
+package fakecloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsrequest "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/redshift/redshiftiface"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/gruntwork-io/cloud-nuke/aws"
+)
+
+// The CloudNuke:: types whose resources the fake aws-sdk-go (v1) EC2 client serves. Final snapshots of EBS volumes
+// are resources of the EBS snapshot type, which CloudNuke::FinalSnapshot::Expired lists once their expiry tag has
+// passed.
+var (
+	ebsSnapshotType = aws.EBSSnapshots{}.TypeName()
+	amiType         = aws.AMIs{}.TypeName()
+	defaultVPCType  = aws.DefaultVPCs{}.TypeName()
+)
+
+// SimulatedCustomTypes are the CloudNuke:: types whose handlers run against the fake cloud. Resources of the other
+// CloudNuke:: types, and of plugin types, can't be simulated.
+var SimulatedCustomTypes = []string{ebsSnapshotType, amiType, defaultVPCType, aws.ExpiredFinalSnapshots{}.TypeName()}
+
+// EC2Service is a fake aws-sdk-go (v1) EC2 client of a region of a Cloud, which serves the resources of the
+// CloudNuke::EC2:: types: EBS snapshots, AMIs and default VPCs, whose tags are their Tags property. Default VPCs have
+// no subnets or internet gateways. Its other operations are not simulated, and panic.
+type EC2Service struct {
+	ec2iface.EC2API
+	cloud  *Cloud
+	region string
+}
+
+func (client *EC2Service) DescribeSnapshotsPagesWithContext(ctx awsgo.Context, input *ec2.DescribeSnapshotsInput, fn func(*ec2.DescribeSnapshotsOutput, bool) bool, opts ...awsrequest.Option) error {
+	if err := client.cloud.call(ctx, "DescribeSnapshots", client.region, ebsSnapshotType, ""); err != nil {
+		return err
+	}
+
+	tagKeys := []string{}
+	for _, filter := range input.Filters {
+		if awsgo.StringValue(filter.Name) == "tag-key" {
+			tagKeys = append(tagKeys, awsgo.StringValueSlice(filter.Values)...)
+		}
+	}
+
+	snapshots := []*ec2.Snapshot{}
+	for _, resource := range client.cloud.Resources(client.region) {
+		if resource.TypeName != ebsSnapshotType || !hasAnyTagKey(resource.tags(), tagKeys) {
+			continue
+		}
+		snapshots = append(snapshots, &ec2.Snapshot{SnapshotId: awsgo.String(resource.Identifier), Tags: ec2Tags(resource.tags())})
+	}
+
+	for start := 0; start == 0 || start < len(snapshots); start += client.cloud.pageSize(nil) {
+		end := minInt(start+client.cloud.pageSize(nil), len(snapshots))
+		if !fn(&ec2.DescribeSnapshotsOutput{Snapshots: snapshots[start:end]}, end == len(snapshots)) {
+			break
+		}
+	}
+	return nil
+}
+
+func (client *EC2Service) DeleteSnapshotWithContext(ctx awsgo.Context, input *ec2.DeleteSnapshotInput, opts ...awsrequest.Option) (*ec2.DeleteSnapshotOutput, error) {
+	return &ec2.DeleteSnapshotOutput{}, client.cloud.deleteNow(ctx, "DeleteSnapshot", client.region, ebsSnapshotType, awsgo.StringValue(input.SnapshotId))
+}
+
+func (client *EC2Service) DescribeImagesPagesWithContext(ctx awsgo.Context, input *ec2.DescribeImagesInput, fn func(*ec2.DescribeImagesOutput, bool) bool, opts ...awsrequest.Option) error {
+	if err := client.cloud.call(ctx, "DescribeImages", client.region, amiType, ""); err != nil {
+		return err
+	}
+
+	images := []*ec2.Image{}
+	for _, resource := range client.cloud.Resources(client.region) {
+		if resource.TypeName == amiType {
+			images = append(images, &ec2.Image{ImageId: awsgo.String(resource.Identifier), Tags: ec2Tags(resource.tags())})
+		}
+	}
+
+	for start := 0; start == 0 || start < len(images); start += client.cloud.pageSize(nil) {
+		end := minInt(start+client.cloud.pageSize(nil), len(images))
+		if !fn(&ec2.DescribeImagesOutput{Images: images[start:end]}, end == len(images)) {
+			break
+		}
+	}
+	return nil
+}
+
+func (client *EC2Service) DeregisterImageWithContext(ctx awsgo.Context, input *ec2.DeregisterImageInput, opts ...awsrequest.Option) (*ec2.DeregisterImageOutput, error) {
+	return &ec2.DeregisterImageOutput{}, client.cloud.deleteNow(ctx, "DeregisterImage", client.region, amiType, awsgo.StringValue(input.ImageId))
+}
+
+// DescribeVpcsWithContext returns the default VPCs of the region. The filters of the request are ignored.
+func (client *EC2Service) DescribeVpcsWithContext(ctx awsgo.Context, input *ec2.DescribeVpcsInput, opts ...awsrequest.Option) (*ec2.DescribeVpcsOutput, error) {
+	if err := client.cloud.call(ctx, "DescribeVpcs", client.region, defaultVPCType, ""); err != nil {
+		return nil, err
+	}
+
+	vpcs := []*ec2.Vpc{}
+	for _, resource := range client.cloud.Resources(client.region) {
+		if resource.TypeName == defaultVPCType {
+			vpcs = append(vpcs, &ec2.Vpc{VpcId: awsgo.String(resource.Identifier), IsDefault: awsgo.Bool(true), Tags: ec2Tags(resource.tags())})
+		}
+	}
+	return &ec2.DescribeVpcsOutput{Vpcs: vpcs}, nil
+}
+
+func (client *EC2Service) DescribeInternetGatewaysWithContext(ctx awsgo.Context, input *ec2.DescribeInternetGatewaysInput, opts ...awsrequest.Option) (*ec2.DescribeInternetGatewaysOutput, error) {
+	if err := client.cloud.call(ctx, "DescribeInternetGateways", client.region, defaultVPCType, ""); err != nil {
+		return nil, err
+	}
+	return &ec2.DescribeInternetGatewaysOutput{}, nil
+}
+
+func (client *EC2Service) DescribeSubnetsWithContext(ctx awsgo.Context, input *ec2.DescribeSubnetsInput, opts ...awsrequest.Option) (*ec2.DescribeSubnetsOutput, error) {
+	if err := client.cloud.call(ctx, "DescribeSubnets", client.region, defaultVPCType, ""); err != nil {
+		return nil, err
+	}
+	return &ec2.DescribeSubnetsOutput{}, nil
+}
+
+func (client *EC2Service) DeleteVpcWithContext(ctx awsgo.Context, input *ec2.DeleteVpcInput, opts ...awsrequest.Option) (*ec2.DeleteVpcOutput, error) {
+	return &ec2.DeleteVpcOutput{}, client.cloud.deleteNow(ctx, "DeleteVpc", client.region, defaultVPCType, awsgo.StringValue(input.VpcId))
+}
+
+// RDS is a fake aws-sdk-go (v1) RDS client of a region of a Cloud, which has no snapshots. Its other operations are
+// not simulated, and panic.
+type RDS struct {
+	rdsiface.RDSAPI
+	cloud  *Cloud
+	region string
+}
+
+func (client *RDS) DescribeDBSnapshotsPagesWithContext(ctx awsgo.Context, input *rds.DescribeDBSnapshotsInput, fn func(*rds.DescribeDBSnapshotsOutput, bool) bool, opts ...awsrequest.Option) error {
+	if err := client.cloud.call(ctx, "DescribeDBSnapshots", client.region, "", ""); err != nil {
+		return err
+	}
+	fn(&rds.DescribeDBSnapshotsOutput{}, true)
+	return nil
+}
+
+func (client *RDS) DescribeDBClusterSnapshotsPagesWithContext(ctx awsgo.Context, input *rds.DescribeDBClusterSnapshotsInput, fn func(*rds.DescribeDBClusterSnapshotsOutput, bool) bool, opts ...awsrequest.Option) error {
+	if err := client.cloud.call(ctx, "DescribeDBClusterSnapshots", client.region, "", ""); err != nil {
+		return err
+	}
+	fn(&rds.DescribeDBClusterSnapshotsOutput{}, true)
+	return nil
+}
+
+// ElastiCache is a fake aws-sdk-go (v1) ElastiCache client of a region of a Cloud, which has no snapshots. Its other
+// operations are not simulated, and panic.
+type ElastiCache struct {
+	elasticacheiface.ElastiCacheAPI
+	cloud  *Cloud
+	region string
+}
+
+func (client *ElastiCache) DescribeSnapshotsPagesWithContext(ctx awsgo.Context, input *elasticache.DescribeSnapshotsInput, fn func(*elasticache.DescribeSnapshotsOutput, bool) bool, opts ...awsrequest.Option) error {
+	if err := client.cloud.call(ctx, "DescribeCacheSnapshots", client.region, "", ""); err != nil {
+		return err
+	}
+	fn(&elasticache.DescribeSnapshotsOutput{}, true)
+	return nil
+}
+
+// Redshift is a fake aws-sdk-go (v1) Redshift client of a region of a Cloud, which has no snapshots. Its other
+// operations are not simulated, and panic.
+type Redshift struct {
+	redshiftiface.RedshiftAPI
+	cloud  *Cloud
+	region string
+}
+
+func (client *Redshift) DescribeClusterSnapshotsPagesWithContext(ctx awsgo.Context, input *redshift.DescribeClusterSnapshotsInput, fn func(*redshift.DescribeClusterSnapshotsOutput, bool) bool, opts ...awsrequest.Option) error {
+	if err := client.cloud.call(ctx, "DescribeClusterSnapshots", client.region, "", ""); err != nil {
+		return err
+	}
+	fn(&redshift.DescribeClusterSnapshotsOutput{}, true)
+	return nil
+}
+
+// S3 is a fake aws-sdk-go (v1) S3 client of a region of a Cloud, whose buckets are the AWS::S3::Bucket resources of
+// the cloud, and are empty. Its other operations are not simulated, and panic.
+type S3 struct {
+	s3iface.S3API
+	cloud  *Cloud
+	region string
+}
+
+// GetBucketLocationWithContext returns the region of the bucket, as S3 does: us-east-1 is an empty location
+func (client *S3) GetBucketLocationWithContext(ctx awsgo.Context, input *s3.GetBucketLocationInput, opts ...awsrequest.Option) (*s3.GetBucketLocationOutput, error) {
+	bucket := awsgo.StringValue(input.Bucket)
+	if err := client.cloud.call(ctx, "GetBucketLocation", client.region, "AWS::S3::Bucket", bucket); err != nil {
+		return nil, err
+	}
+
+	client.cloud.mutex.Lock()
+	defer client.cloud.mutex.Unlock()
+
+	for region := range client.cloud.resources {
+		if client.cloud.find(region, "AWS::S3::Bucket", bucket) == nil {
+			continue
+		}
+		if region == "us-east-1" {
+			region = ""
+		}
+		return &s3.GetBucketLocationOutput{LocationConstraint: awsgo.String(region)}, nil
+	}
+	return nil, awserr.New(s3.ErrCodeNoSuchBucket, fmt.Sprintf("The specified bucket %s does not exist", bucket), nil)
+}
+
+func (client *S3) ListObjectVersionsPagesWithContext(ctx awsgo.Context, input *s3.ListObjectVersionsInput, fn func(*s3.ListObjectVersionsOutput, bool) bool, opts ...awsrequest.Option) error {
+	if err := client.cloud.call(ctx, "ListObjectVersions", client.region, "AWS::S3::Bucket", awsgo.StringValue(input.Bucket)); err != nil {
+		return err
+	}
+	fn(&s3.ListObjectVersionsOutput{}, true)
+	return nil
+}
+
+// ECR is a fake aws-sdk-go (v1) ECR client of a region of a Cloud, whose repositories are empty. Its other operations
+// are not simulated, and panic.
+type ECR struct {
+	ecriface.ECRAPI
+	cloud  *Cloud
+	region string
+}
+
+func (client *ECR) ListImagesPagesWithContext(ctx awsgo.Context, input *ecr.ListImagesInput, fn func(*ecr.ListImagesOutput, bool) bool, opts ...awsrequest.Option) error {
+	if err := client.cloud.call(ctx, "ListImages", client.region, "AWS::ECR::Repository", awsgo.StringValue(input.RepositoryName)); err != nil {
+		return err
+	}
+	fn(&ecr.ListImagesOutput{}, true)
+	return nil
+}
+
+// Route53 is a fake aws-sdk-go (v1) Route 53 client of a Cloud, whose hosted zones only hold the records of their
+// apex. The name of a zone is its Name property, or else its identifier. Its other operations are not simulated, and
+// panic.
+type Route53 struct {
+	route53iface.Route53API
+	cloud  *Cloud
+	region string
+}
+
+func (client *Route53) GetHostedZoneWithContext(ctx awsgo.Context, input *route53.GetHostedZoneInput, opts ...awsrequest.Option) (*route53.GetHostedZoneOutput, error) {
+	identifier := awsgo.StringValue(input.Id)
+	if err := client.cloud.call(ctx, "GetHostedZone", client.region, "AWS::Route53::HostedZone", identifier); err != nil {
+		return nil, err
+	}
+
+	client.cloud.mutex.Lock()
+	defer client.cloud.mutex.Unlock()
+
+	for region := range client.cloud.resources {
+		zone := client.cloud.find(region, "AWS::Route53::HostedZone", identifier)
+		if zone == nil {
+			continue
+		}
+		name, ok := zone.Properties["Name"].(string)
+		if !ok {
+			name = identifier
+		}
+		return &route53.GetHostedZoneOutput{HostedZone: &route53.HostedZone{
+			Id:   awsgo.String(identifier),
+			Name: awsgo.String(strings.TrimSuffix(name, ".") + "."),
+		}}, nil
+	}
+	return nil, awserr.New(route53.ErrCodeNoSuchHostedZone, fmt.Sprintf("No hosted zone found with ID: %s", identifier), nil)
+}
+
+func (client *Route53) ListResourceRecordSetsPagesWithContext(ctx awsgo.Context, input *route53.ListResourceRecordSetsInput, fn func(*route53.ListResourceRecordSetsOutput, bool) bool, opts ...awsrequest.Option) error {
+	if err := client.cloud.call(ctx, "ListResourceRecordSets", client.region, "AWS::Route53::HostedZone", awsgo.StringValue(input.HostedZoneId)); err != nil {
+		return err
+	}
+	fn(&route53.ListResourceRecordSetsOutput{}, true)
+	return nil
+}
+
+// deleteNow deletes a resource right away, as the service APIs other than Cloud Control do, failing with an
+// aws-sdk-go (v1) error when the resource is missing, has a DeleteFailure, or is in use by another resource
+func (cloud *Cloud) deleteNow(ctx awsgo.Context, operation, region, typeName, identifier string) error {
+	if err := cloud.call(ctx, operation, region, typeName, identifier); err != nil {
+		return err
+	}
+
+	cloud.mutex.Lock()
+	defer cloud.mutex.Unlock()
+
+	resource := cloud.find(region, typeName, identifier)
+	if resource == nil {
+		return awserr.New("NotFound", fmt.Sprintf("%s %s was not found", typeName, identifier), nil)
+	}
+	if resource.DeleteFailure != "" {
+		return awserr.New(string(resource.DeleteFailure), fmt.Sprintf("Deletion of %s %s failed with %s", typeName, identifier, resource.DeleteFailure), nil)
+	}
+	if dependents := cloud.dependents(region, identifier); len(dependents) > 0 {
+		return awserr.New("DependencyViolation", fmt.Sprintf("%s %s is in use by %s", typeName, identifier, strings.Join(dependents, ", ")), nil)
+	}
+	cloud.remove(region, typeName, identifier)
+	return nil
+}
+
+// tags returns the tags of the resource, from its Tags property of Key and Value pairs
+func (resource Resource) tags() map[string]string {
+	encoded, err := json.Marshal(resource.Properties["Tags"])
+	if err != nil {
+		return map[string]string{}
+	}
+	pairs := []struct {
+		Key   string
+		Value string
+	}{}
+	json.Unmarshal(encoded, &pairs)
+
+	tags := map[string]string{}
+	for _, pair := range pairs {
+		tags[pair.Key] = pair.Value
+	}
+	return tags
+}
+
+// hasAnyTagKey returns true if the tags have one of the keys, as the tag-key filter of EC2 matches, or if there are no
+// keys to match
+func hasAnyTagKey(tags map[string]string, keys []string) bool {
+	for _, key := range keys {
+		if _, ok := tags[key]; ok {
+			return true
+		}
+	}
+	return len(keys) == 0
+}
+
+func ec2Tags(tags map[string]string) []*ec2.Tag {
+	ec2Tags := []*ec2.Tag{}
+	for key, value := range tags {
+		ec2Tags = append(ec2Tags, &ec2.Tag{Key: awsgo.String(key), Value: awsgo.String(value)})
+	}
+	return ec2Tags
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
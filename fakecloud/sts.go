@@ -0,0 +1,95 @@
+package fakecloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go-v2/service/sts/types"
+	v1aws "github.com/aws/aws-sdk-go/aws"
+	awsrequest "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/gruntwork-io/cloud-nuke/aws"
+)
+
+// DefaultAccountID is the account of clouds whose Account isn't set
+const DefaultAccountID = "123456789012"
+
+// STS is a fake STS client of a region of a Cloud, which identifies the caller as the account of the cloud
+type STS struct {
+	cloud  *Cloud
+	region string
+}
+
+// GetCallerIdentity returns the account and caller ARN of the cloud
+func (client *STS) GetCallerIdentity(ctx context.Context, params *sts.GetCallerIdentityInput, optFns ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
+	if err := client.cloud.call(ctx, "GetCallerIdentity", client.region, "", ""); err != nil {
+		return nil, err
+	}
+
+	account := client.cloud.account()
+	return &sts.GetCallerIdentityOutput{
+		Account: awsgo.String(account.AccountID),
+		Arn:     awsgo.String(account.CallerArn),
+		UserId:  awsgo.String("AIDAFAKECLOUD"),
+	}, nil
+}
+
+// AssumeRole returns credentials that the fake cloud accepts, valid for an hour, for any role
+func (client *STS) AssumeRole(ctx context.Context, params *sts.AssumeRoleInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleOutput, error) {
+	if err := client.cloud.call(ctx, "AssumeRole", client.region, "", awsgo.ToString(params.RoleArn)); err != nil {
+		return nil, err
+	}
+
+	return &sts.AssumeRoleOutput{
+		AssumedRoleUser: &types.AssumedRoleUser{
+			Arn:           params.RoleArn,
+			AssumedRoleId: awsgo.String(fmt.Sprintf("AROAFAKECLOUD:%s", awsgo.ToString(params.RoleSessionName))),
+		},
+		Credentials: &types.Credentials{
+			AccessKeyId:     awsgo.String("ASIAFAKECLOUD"),
+			SecretAccessKey: awsgo.String("fakecloud"),
+			SessionToken:    awsgo.String("fakecloud"),
+			Expiration:      awsgo.Time(time.Now().Add(time.Hour)),
+		},
+	}, nil
+}
+
+// IAM is a fake aws-sdk-go (v1) IAM client of a Cloud, which returns the alias of the account. Its other operations
+// are not simulated, and panic.
+type IAM struct {
+	iamiface.IAMAPI
+	cloud  *Cloud
+	region string
+}
+
+// ListAccountAliasesWithContext returns the alias of the account of the cloud, if it has one
+func (client *IAM) ListAccountAliasesWithContext(ctx v1aws.Context, input *iam.ListAccountAliasesInput, opts ...awsrequest.Option) (*iam.ListAccountAliasesOutput, error) {
+	if err := client.cloud.call(ctx, "ListAccountAliases", client.region, "", ""); err != nil {
+		return nil, err
+	}
+
+	aliases := []*string{}
+	if alias := client.cloud.account().Alias; alias != "" {
+		aliases = append(aliases, v1aws.String(alias))
+	}
+	return &iam.ListAccountAliasesOutput{AccountAliases: aliases}, nil
+}
+
+// account returns the account of the cloud, with the defaults of the fields that aren't set
+func (cloud *Cloud) account() aws.AccountIdentity {
+	cloud.mutex.Lock()
+	defer cloud.mutex.Unlock()
+
+	account := cloud.Account
+	if account.AccountID == "" {
+		account.AccountID = DefaultAccountID
+	}
+	if account.CallerArn == "" {
+		account.CallerArn = fmt.Sprintf("arn:aws:iam::%s:user/fakecloud", account.AccountID)
+	}
+	return account
+}